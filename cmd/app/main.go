@@ -18,6 +18,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
@@ -46,7 +47,7 @@ func main() {
 	// Initialize config.
 	cfg, err := config.Init()
 	if err != nil {
-		log.Error().Err(err).Msg("error initialize config")
+		log.Fatal().Err(err).Msg("error initialize config")
 	}
 
 	// Creating a new gRPC client.
@@ -64,11 +65,19 @@ func main() {
 	// Run server.
 	go srv.Run()
 
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Run deleted users purge.
+	go service.RunPurge(ctx)
+
 	// Quit in application.
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
 
+	// Stopping deleted users purge.
+	cancel()
+
 	// Stopping server.
 	srv.Stop()
 
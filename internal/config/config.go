@@ -18,10 +18,12 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 )
@@ -36,6 +38,7 @@ type (
 		Database DatabaseConfig
 		Password PasswordConfig
 		Code     CodeConfig
+		User     UserConfig
 		Auth     AuthConfig
 		Service  ServiceConfig
 	}
@@ -62,26 +65,164 @@ type (
 
 	// Code config variables.
 	CodeConfig struct {
+		TTL         time.Duration `mapstructure:"ttl"`
+		MaxLength   int64         `mapstructure:"max-length"`
+		MinLength   int64         `mapstructure:"min-length"`
+		SendWindow  time.Duration `mapstructure:"send-window"`
+		SendLimit   int64         `mapstructure:"send-limit"`
+		IPSendLimit int64         `mapstructure:"ip-send-limit"`
+		MaxAttempts int64         `mapstructure:"max-attempts"`
+
+		// Purposes overrides code settings by purpose name.
+		Purposes map[string]CodePurposeConfig `mapstructure:"purposes"`
+	}
+
+	// Code purpose config variables.
+	CodePurposeConfig struct {
 		TTL       time.Duration `mapstructure:"ttl"`
 		MaxLength int64         `mapstructure:"max-length"`
 		MinLength int64         `mapstructure:"min-length"`
 	}
 
+	// User config variables.
+	UserConfig struct {
+		EmailChange EmailChangeConfig `mapstructure:"email-change"`
+		Username    UsernameConfig    `mapstructure:"username"`
+		Avatar      AvatarConfig      `mapstructure:"avatar"`
+		Lookup      LookupConfig      `mapstructure:"lookup"`
+		Search      SearchConfig      `mapstructure:"search"`
+		LastVisit   LastVisitConfig   `mapstructure:"last-visit"`
+		Deletion    DeletionConfig    `mapstructure:"deletion"`
+	}
+
+	// User account deletion config variables.
+	DeletionConfig struct {
+		// Time a deleted account can be restored by signing in.
+		GracePeriod time.Duration `mapstructure:"grace-period"`
+		// Time between purges of deleted accounts after the grace period.
+		PurgeInterval time.Duration `mapstructure:"purge-interval"`
+	}
+
+	// User last visit config variables.
+	LastVisitConfig struct {
+		// Minimum time between user last visit updates.
+		Interval time.Duration `mapstructure:"interval"`
+	}
+
+	// User search config variables.
+	SearchConfig struct {
+		DefaultLimit int `mapstructure:"default-limit"`
+		MaxLimit     int `mapstructure:"max-limit"`
+	}
+
+	// User lookup config variables.
+	LookupConfig struct {
+		// Maximum number of ids in a batch lookup.
+		MaxIds int `mapstructure:"max-ids"`
+	}
+
+	// User avatar config variables.
+	AvatarConfig struct {
+		Hosts     []string `mapstructure:"hosts"`
+		Schemes   []string `mapstructure:"schemes"`
+		MaxLength int      `mapstructure:"max-length"`
+	}
+
+	// Username change config variables.
+	UsernameConfig struct {
+		// Minimum time between username changes.
+		Cooldown time.Duration `mapstructure:"cooldown"`
+		// Time a released username is reserved for its previous owner.
+		Reservation time.Duration `mapstructure:"reservation"`
+	}
+
+	// User email address change config variables.
+	EmailChangeConfig struct {
+		RevertURL string        `mapstructure:"revert-url"`
+		RevertTTL time.Duration `mapstructure:"revert-ttl"`
+	}
+
 	// Auth config variables.
 	AuthConfig struct {
-		JWT     JWTConfig     `mapstructure:"jwt"`
-		Session SessionConfig `mapstructure:"session"`
+		JWT          JWTConfig          `mapstructure:"jwt"`
+		Session      SessionConfig      `mapstructure:"session"`
+		MFA          MFAConfig          `mapstructure:"mfa"`
+		WebAuthn     WebAuthnConfig     `mapstructure:"webauthn"`
+		Attempt      AttemptConfig      `mapstructure:"attempt"`
+		MagicLink    MagicLinkConfig    `mapstructure:"magic-link"`
+		Verification VerificationConfig `mapstructure:"verification"`
 	}
 
 	// JWT config variables.
 	JWTConfig struct {
 		SigningKey string
-		TTL        time.Duration `mapstructure:"ttl"`
+		TTL        time.Duration  `mapstructure:"ttl"`
+		Issuer     string         `mapstructure:"issuer"`
+		Audience   string         `mapstructure:"audience"`
+		Roles      []string       `mapstructure:"roles"`
+		Scopes     []string       `mapstructure:"scopes"`
+		Keys       []JWTKeyConfig `mapstructure:"keys"`
+	}
+
+	// JWT signing key config variables.
+	JWTKeyConfig struct {
+		Id         string    `mapstructure:"id"`
+		Algorithm  string    `mapstructure:"algorithm"`
+		Key        string    `mapstructure:"key"`
+		ActiveFrom time.Time `mapstructure:"active-from"`
+		ExpiresAt  time.Time `mapstructure:"expires-at"`
 	}
 
 	// User session config variables.
 	SessionConfig struct {
-		TTL time.Duration `mapstructure:"ttl"`
+		HashKey         string
+		TTL             time.Duration `mapstructure:"ttl"`
+		LegacyHashUntil time.Time     `mapstructure:"legacy-hash-until"`
+	}
+
+	// Multi-factor authentication config variables.
+	MFAConfig struct {
+		EncryptionKey string
+		Issuer        string        `mapstructure:"issuer"`
+		ChallengeTTL  time.Duration `mapstructure:"challenge-ttl"`
+		RecoveryCodes int           `mapstructure:"recovery-codes"`
+		// Maximum failed attempts of a challenge before it is deleted.
+		MaxAttempts int64 `mapstructure:"max-attempts"`
+	}
+
+	// WebAuthn relying party config variables.
+	WebAuthnConfig struct {
+		RPID         string        `mapstructure:"rp-id"`
+		RPName       string        `mapstructure:"rp-name"`
+		Origins      []string      `mapstructure:"origins"`
+		ChallengeTTL time.Duration `mapstructure:"challenge-ttl"`
+	}
+
+	// Magic sign in link config variables.
+	MagicLinkConfig struct {
+		URL         string        `mapstructure:"url"`
+		TTL         time.Duration `mapstructure:"ttl"`
+		SendWindow  time.Duration `mapstructure:"send-window"`
+		SendLimit   int64         `mapstructure:"send-limit"`
+		IPSendLimit int64         `mapstructure:"ip-send-limit"`
+	}
+
+	// User email verification config variables.
+	VerificationConfig struct {
+		// Unverified user sign in policy: "allow", "deny" or "limited".
+		Unverified string `mapstructure:"unverified"`
+		// Access token scopes of unverified users with the "limited" policy.
+		Scopes []string `mapstructure:"scopes"`
+	}
+
+	// Sign in brute-force protection config variables.
+	AttemptConfig struct {
+		Window      time.Duration `mapstructure:"window"`
+		BaseDelay   time.Duration `mapstructure:"base-delay"`
+		MaxDelay    time.Duration `mapstructure:"max-delay"`
+		Threshold   int64         `mapstructure:"threshold"`
+		IPThreshold int64         `mapstructure:"ip-threshold"`
+		Lockout     time.Duration `mapstructure:"lockout"`
 	}
 
 	// Database config variables.
@@ -131,6 +272,19 @@ func Init() (*Config, error) {
 	// Set configurations from environment.
 	setFromEnv(&cfg)
 
+	// Accept legacy session hashes for one session ttl after startup unless set explicitly, sessions
+	// stored before the migration cannot outlive it.
+	if cfg.Auth.Session.LegacyHashUntil.IsZero() {
+		cfg.Auth.Session.LegacyHashUntil = time.Now().Add(cfg.Auth.Session.TTL)
+	}
+
+	// Check that secret keys are set.
+	if cfg.Auth.Session.HashKey == "" {
+		return nil, errors.New("SESSION_HASH_KEY is not set")
+	} else if cfg.Auth.MFA.EncryptionKey == "" {
+		return nil, errors.New("MFA_ENCRYPTION_KEY is not set")
+	}
+
 	return &cfg, nil
 }
 
@@ -168,8 +322,17 @@ func unmarshal(cfg *Config) error {
 	if err := viper.UnmarshalKey("code", &cfg.Code); err != nil {
 		return err
 	}
+	// Unmarshal user keys.
+	if err := viper.UnmarshalKey("user", &cfg.User); err != nil {
+		return err
+	}
 	// Unmarshal auth keys.
-	if err := viper.UnmarshalKey("auth", &cfg.Auth); err != nil {
+	if err := viper.UnmarshalKey("auth", &cfg.Auth, viper.DecodeHook(
+		mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToTimeHookFunc(time.RFC3339),
+		),
+	)); err != nil {
 		return err
 	}
 	// Unmarshal postgres database keys.
@@ -196,4 +359,6 @@ func setFromEnv(cfg *Config) {
 
 	// Auth variables.
 	cfg.Auth.JWT.SigningKey = os.Getenv("JWT_SIGNING_KEY")
+	cfg.Auth.Session.HashKey = os.Getenv("SESSION_HASH_KEY")
+	cfg.Auth.MFA.EncryptionKey = os.Getenv("MFA_ENCRYPTION_KEY")
 }
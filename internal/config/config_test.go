@@ -29,7 +29,9 @@ import (
 // Test initialize config.
 func TestConfig_Init(t *testing.T) {
 	// Environment configurations.
-	type env struct{ configPath, postgresURL, redisURL, jwtSigningKey string }
+	type env struct {
+		configPath, postgresURL, redisURL, jwtSigningKey, sessionHashKey, mfaEncryptionKey string
+	}
 
 	// Testing args.
 	type args struct{ env env }
@@ -40,6 +42,8 @@ func TestConfig_Init(t *testing.T) {
 		os.Setenv("POSTGRES_URL", env.postgresURL)
 		os.Setenv("REDIS_URL", env.redisURL)
 		os.Setenv("JWT_SIGNING_KEY", env.jwtSigningKey)
+		os.Setenv("SESSION_HASH_KEY", env.sessionHashKey)
+		os.Setenv("MFA_ENCRYPTION_KEY", env.mfaEncryptionKey)
 	}
 
 	// Tests structures.
@@ -52,10 +56,12 @@ func TestConfig_Init(t *testing.T) {
 		{
 			name: "OK",
 			args: args{env: env{
-				configPath:    "fixtures/main",
-				postgresURL:   "postgres://localhost:1",
-				redisURL:      "redis://user.redis.durudex.local:6379",
-				jwtSigningKey: "secret-key",
+				configPath:       "fixtures/main",
+				postgresURL:      "postgres://localhost:1",
+				redisURL:         "redis://user.redis.durudex.local:6379",
+				jwtSigningKey:    "secret-key",
+				sessionHashKey:   "hash-key",
+				mfaEncryptionKey: "encryption-key",
 			}},
 			want: &config.Config{
 				GRPC: config.GRPCConfig{
@@ -78,16 +84,103 @@ func TestConfig_Init(t *testing.T) {
 				},
 				Password: config.PasswordConfig{Cost: 14},
 				Code: config.CodeConfig{
-					TTL:       time.Minute * 15,
-					MaxLength: 999999,
-					MinLength: 100000,
+					TTL:         time.Minute * 15,
+					MaxLength:   999999,
+					MinLength:   100000,
+					SendWindow:  time.Hour,
+					SendLimit:   5,
+					IPSendLimit: 20,
+					MaxAttempts: 5,
+					Purposes: map[string]config.CodePurposeConfig{
+						"sign-up":        {TTL: time.Minute * 15},
+						"password-reset": {TTL: time.Minute * 15},
+						"email-change":   {TTL: time.Minute * 30},
+						"mfa": {
+							TTL:       time.Minute * 5,
+							MaxLength: 99999999,
+							MinLength: 10000000,
+						},
+					},
+				},
+				User: config.UserConfig{
+					EmailChange: config.EmailChangeConfig{
+						RevertURL: "https://durudex.com/auth/email-revert",
+						RevertTTL: time.Hour * 168,
+					},
+					Username: config.UsernameConfig{
+						Cooldown:    time.Hour * 720,
+						Reservation: time.Hour * 2160,
+					},
+					Avatar: config.AvatarConfig{
+						Hosts:     []string{"cdn.durudex.com"},
+						Schemes:   []string{"https"},
+						MaxLength: 255,
+					},
+					Lookup: config.LookupConfig{MaxIds: 100},
+					Search: config.SearchConfig{
+						DefaultLimit: 20,
+						MaxLimit:     100,
+					},
+					LastVisit: config.LastVisitConfig{Interval: time.Minute * 5},
+					Deletion: config.DeletionConfig{
+						GracePeriod:   time.Hour * 720,
+						PurgeInterval: time.Hour,
+					},
 				},
 				Auth: config.AuthConfig{
 					JWT: config.JWTConfig{
 						SigningKey: "secret-key",
 						TTL:        time.Minute * 15,
+						Issuer:     "user.service.durudex.local",
+						Audience:   "durudex",
+						Roles:      []string{"user"},
+						Scopes:     []string{"user"},
+						Keys: []config.JWTKeyConfig{
+							{
+								Id:         "2022-08",
+								Algorithm:  "EdDSA",
+								Key:        "./certs/jwt-2022-08.pem",
+								ActiveFrom: time.Date(2022, time.August, 1, 0, 0, 0, 0, time.UTC),
+								ExpiresAt:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+							},
+						},
+					},
+					Session: config.SessionConfig{
+						HashKey: "hash-key",
+						TTL:     time.Hour * 720,
+					},
+					MFA: config.MFAConfig{
+						EncryptionKey: "encryption-key",
+						Issuer:        "Durudex",
+						ChallengeTTL:  time.Minute * 5,
+						RecoveryCodes: 10,
+						MaxAttempts:   5,
+					},
+					WebAuthn: config.WebAuthnConfig{
+						RPID:         "durudex.com",
+						RPName:       "Durudex",
+						Origins:      []string{"https://durudex.com"},
+						ChallengeTTL: time.Minute * 5,
+					},
+					Attempt: config.AttemptConfig{
+						Window:      time.Minute * 15,
+						BaseDelay:   time.Second,
+						MaxDelay:    time.Minute * 5,
+						Threshold:   10,
+						IPThreshold: 100,
+						Lockout:     time.Minute * 15,
+					},
+					MagicLink: config.MagicLinkConfig{
+						URL:         "https://durudex.com/auth/magic-link",
+						TTL:         time.Minute * 15,
+						SendWindow:  time.Hour,
+						SendLimit:   5,
+						IPSendLimit: 20,
+					},
+					Verification: config.VerificationConfig{
+						Unverified: "limited",
+						Scopes:     []string{"user:verify"},
 					},
-					Session: config.SessionConfig{TTL: time.Hour * 720},
 				},
 				Service: config.ServiceConfig{
 					Email: config.Service{
@@ -102,6 +195,22 @@ func TestConfig_Init(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Session Hash Key Not Set",
+			args: args{env: env{
+				configPath:       "fixtures/main",
+				mfaEncryptionKey: "encryption-key",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "MFA Encryption Key Not Set",
+			args: args{env: env{
+				configPath:     "fixtures/main",
+				sessionHashKey: "hash-key",
+			}},
+			wantErr: true,
+		},
 	}
 
 	// Conducting tests in various structures.
@@ -110,10 +219,21 @@ func TestConfig_Init(t *testing.T) {
 			// Set environments configurations.
 			setEnv(tt.args.env)
 
+			start := time.Now()
+
 			// Initialize config.
 			got, err := config.Init()
 			if (err != nil) != tt.wantErr {
-				t.Errorf("error initialize config: %s", err.Error())
+				t.Errorf("error initialize config: %v", err)
+			}
+
+			// Check that the legacy session hash deadline defaults to startup time plus session ttl.
+			if got != nil {
+				until := got.Auth.Session.LegacyHashUntil
+				if until.Before(start.Add(got.Auth.Session.TTL)) || until.After(time.Now().Add(got.Auth.Session.TTL)) {
+					t.Errorf("error legacy session hash deadline: %s", until)
+				}
+				got.Auth.Session.LegacyHashUntil = time.Time{}
 			}
 
 			// Check for similarity of a config.
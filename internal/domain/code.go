@@ -0,0 +1,58 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package domain
+
+// Verification code purpose.
+type CodePurpose int
+
+// Verification code purposes.
+const (
+	CodePurposeUnspecified CodePurpose = iota
+	CodePurposeSignUp
+	CodePurposePasswordReset
+	CodePurposeEmailChange
+	CodePurposeMFA
+	CodePurposeEmailVerification
+)
+
+// Getting verification code purpose name.
+func (p CodePurpose) String() string {
+	switch p {
+	case CodePurposeSignUp:
+		return "sign-up"
+	case CodePurposePasswordReset:
+		return "password-reset"
+	case CodePurposeEmailChange:
+		return "email-change"
+	case CodePurposeMFA:
+		return "mfa"
+	case CodePurposeEmailVerification:
+		return "email-verification"
+	}
+
+	return "unspecified"
+}
+
+// Validate verification code purpose.
+func (p CodePurpose) Validate() error {
+	if p <= CodePurposeUnspecified || p > CodePurposeEmailVerification {
+		return &Error{Code: CodeInvalidArgument, Message: "Invalid Code Purpose"}
+	}
+
+	return nil
+}
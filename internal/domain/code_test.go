@@ -0,0 +1,57 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package domain
+
+import "testing"
+
+// Testing validate verification code purpose.
+func TestCodePurpose_Validate(t *testing.T) {
+	// Tests structures.
+	tests := []struct {
+		name    string
+		purpose CodePurpose
+		wantErr bool
+	}{
+		{
+			name:    "OK",
+			purpose: CodePurposePasswordReset,
+			wantErr: false,
+		},
+		{
+			name:    "Unspecified",
+			purpose: CodePurposeUnspecified,
+			wantErr: true,
+		},
+		{
+			name:    "Unknown",
+			purpose: CodePurpose(42),
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Validate verification code purpose.
+			err := tt.purpose.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error validation code purpose: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package domain
+
+import "github.com/segmentio/ksuid"
+
+// User WebAuthn credential structure.
+type Credential struct {
+	Id        []byte
+	UserId    ksuid.KSUID
+	PublicKey []byte
+	SignCount uint32
+}
+
+// User WebAuthn ceremony session structure.
+type WebAuthnSession struct {
+	// Ceremony client data type.
+	Type string
+	// User id, empty for discoverable credential login.
+	UserId ksuid.KSUID
+}
+
+// User WebAuthn assertion structure.
+type Assertion struct {
+	CredentialId      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
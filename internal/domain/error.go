@@ -28,6 +28,10 @@ const (
 	CodeNotFound
 	CodeAlreadyExists
 	CodeInvalidArgument
+	CodeUnauthenticated
+	CodeTooManyAttempts
+	CodeLocked
+	CodeFailedPrecondition
 )
 
 // Error structure.
@@ -0,0 +1,49 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package domain
+
+import "github.com/segmentio/ksuid"
+
+// User TOTP authenticator structure.
+type TOTP struct {
+	UserId    ksuid.KSUID
+	Secret    []byte
+	Confirmed bool
+	LastStep  uint64
+}
+
+// User TOTP authenticator enrollment structure.
+type TOTPEnrollment struct {
+	Secret        string
+	URI           string
+	RecoveryCodes []string
+}
+
+// User multi-factor authentication challenge structure.
+type MFAChallenge struct {
+	UserId   ksuid.KSUID
+	Username string
+	Email    string
+}
+
+// User sign in result structure.
+type SignIn struct {
+	Tokens Tokens
+	// Multi-factor authentication challenge token, set if the second factor is required.
+	MFAToken string
+}
@@ -22,12 +22,16 @@ import "regexp"
 // Regular fot validating strings.
 const (
 	Username string = "^[a-zA-Z0-9-_.]{3,40}$"
+	Query    string = "^[a-zA-Z0-9-_.]{0,40}$"
 	Password string = "^[a-zA-Z0-9@$!%*?&]{8,100}$"
+	Locale   string = "^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$"
 	Email    string = "^(?:[a-z0-9!#$%&'*+/=?^_`{|}~-]+(?:\\.[a-z0-9!#$%&'*+/=?^_`{|}~-]+)*|\"(?:[\x01-\x08\x0b\x0c\x0e-\x1f\x21\x23-\x5b\x5d-\x7f]|\\[\x01-\x09\x0b\x0c\x0e-\x7f])*\")@(?:(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\\.)+[a-z0-9](?:[a-z0-9-]*[a-z0-9])?|\\[(?:(?:(2(5[0-5]|[0-4][0-9])|1[0-9][0-9]|[1-9]?[0-9]))\\.){3}(?:(2(5[0-5]|[0-4][0-9])|1[0-9][0-9]|[1-9]?[0-9])|[a-z0-9-]*[a-z0-9]:(?:[\x01-\x08\x0b\x0c\x0e-\x1f\x21-\x5a\x53-\x7f]|\\[\x01-\x09\x0b\x0c\x0e-\x7f])+)\\])"
 )
 
 var (
 	RxUsername = regexp.MustCompile(Username)
+	RxQuery    = regexp.MustCompile(Query)
 	RxPassword = regexp.MustCompile(Password)
+	RxLocale   = regexp.MustCompile(Locale)
 	RxEmail    = regexp.MustCompile(Email)
 )
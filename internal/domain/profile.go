@@ -0,0 +1,161 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package domain
+
+import (
+	"net/url"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	// Embedding time zone database, so time zones can be validated without system files.
+	_ "time/tzdata"
+)
+
+// User profile fields.
+const (
+	ProfileDisplayName string = "display_name"
+	ProfileBio         string = "bio"
+	ProfileLocale      string = "locale"
+	ProfileTimezone    string = "timezone"
+	ProfileLinks       string = "links"
+)
+
+// User profile limits.
+const (
+	MaxDisplayNameLength int = 64
+	MaxBioLength         int = 500
+	MaxLocaleLength      int = 35
+	MaxLinks             int = 5
+	MaxLinkLength        int = 255
+)
+
+// All user profile fields.
+var ProfileFields = []string{ProfileDisplayName, ProfileBio, ProfileLocale, ProfileTimezone, ProfileLinks}
+
+// User profile model.
+type Profile struct {
+	DisplayName string
+	Bio         string
+	Locale      string
+	Timezone    string
+	Links       []string
+}
+
+// Validate user profile fields, all fields are validated if fields are empty.
+func (p Profile) Validate(fields []string) error {
+	// Check if all fields should be validated.
+	if len(fields) == 0 {
+		fields = ProfileFields
+	}
+
+	seen := make(map[string]struct{}, len(fields))
+
+	for _, field := range fields {
+		// Check that the field is not repeated, it would be updated twice.
+		if _, ok := seen[field]; ok {
+			return &Error{Code: CodeInvalidArgument, Message: "Duplicate Profile Field"}
+		}
+		seen[field] = struct{}{}
+
+		switch field {
+		case ProfileDisplayName:
+			if !validateText(p.DisplayName, MaxDisplayNameLength, false) {
+				return &Error{Code: CodeInvalidArgument, Message: "Invalid Display Name"}
+			}
+		case ProfileBio:
+			if !validateText(p.Bio, MaxBioLength, true) {
+				return &Error{Code: CodeInvalidArgument, Message: "Invalid Bio"}
+			}
+		case ProfileLocale:
+			if p.Locale != "" && (len(p.Locale) > MaxLocaleLength || !RxLocale.MatchString(p.Locale)) {
+				return &Error{Code: CodeInvalidArgument, Message: "Invalid Locale"}
+			}
+		case ProfileTimezone:
+			if !validateTimezone(p.Timezone) {
+				return &Error{Code: CodeInvalidArgument, Message: "Invalid Timezone"}
+			}
+		case ProfileLinks:
+			if !validateLinks(p.Links) {
+				return &Error{Code: CodeInvalidArgument, Message: "Invalid Links"}
+			}
+		default:
+			return &Error{Code: CodeInvalidArgument, Message: "Invalid Profile Field"}
+		}
+	}
+
+	return nil
+}
+
+// Validate profile text length and characters, new lines are allowed only in multiline text.
+func validateText(text string, maxLength int, multiline bool) bool {
+	// Check text encoding and length.
+	if !utf8.ValidString(text) || utf8.RuneCountInString(text) > maxLength {
+		return false
+	}
+
+	// Check text characters.
+	for _, r := range text {
+		if unicode.IsControl(r) && !(multiline && r == '\n') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate profile IANA time zone name.
+func validateTimezone(timezone string) bool {
+	if timezone == "" {
+		return true
+	} else if timezone == "Local" {
+		return false
+	}
+
+	// Loading time zone location.
+	_, err := time.LoadLocation(timezone)
+
+	return err == nil
+}
+
+// Validate profile links.
+func validateLinks(links []string) bool {
+	if len(links) > MaxLinks {
+		return false
+	}
+
+	for _, link := range links {
+		// Check link length.
+		if link == "" || len(link) > MaxLinkLength {
+			return false
+		}
+
+		// Parsing link url.
+		u, err := url.Parse(link)
+		if err != nil || u.User != nil || u.Host == "" {
+			return false
+		}
+
+		// Check link scheme.
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return false
+		}
+	}
+
+	return true
+}
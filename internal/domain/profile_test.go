@@ -0,0 +1,127 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+// Testing validate user profile.
+func TestProfile_Validate(t *testing.T) {
+	// Testing args.
+	type args struct {
+		profile Profile
+		fields  []string
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			args: args{profile: Profile{
+				DisplayName: "Durudex",
+				Bio:         "Line one\nLine two",
+				Locale:      "en-US",
+				Timezone:    "Europe/Kiev",
+				Links:       []string{"https://durudex.com"},
+			}},
+			wantErr: false,
+		},
+		{
+			name:    "Empty",
+			args:    args{profile: Profile{}},
+			wantErr: false,
+		},
+		{
+			name:    "Display Name Too Long",
+			args:    args{profile: Profile{DisplayName: strings.Repeat("a", 65)}},
+			wantErr: true,
+		},
+		{
+			name:    "Display Name New Line",
+			args:    args{profile: Profile{DisplayName: "Duru\ndex"}},
+			wantErr: true,
+		},
+		{
+			name:    "Bio Too Long",
+			args:    args{profile: Profile{Bio: strings.Repeat("a", 501)}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Locale",
+			args:    args{profile: Profile{Locale: "en_US"}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Timezone",
+			args:    args{profile: Profile{Timezone: "Mars/Olympus"}},
+			wantErr: true,
+		},
+		{
+			name:    "Local Timezone",
+			args:    args{profile: Profile{Timezone: "Local"}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Link Scheme",
+			args:    args{profile: Profile{Links: []string{"javascript:alert(1)"}}},
+			wantErr: true,
+		},
+		{
+			name: "Too Many Links",
+			args: args{profile: Profile{Links: []string{
+				"https://a.com", "https://b.com", "https://c.com", "https://d.com", "https://e.com", "https://f.com",
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "Only Masked Fields",
+			args: args{
+				profile: Profile{DisplayName: "Durudex", Timezone: "Mars/Olympus"},
+				fields:  []string{ProfileDisplayName},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Duplicate Field",
+			args:    args{profile: Profile{Bio: "Durudex"}, fields: []string{ProfileBio, ProfileBio}},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Field",
+			args:    args{profile: Profile{}, fields: []string{"email"}},
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Validate user profile.
+			err := tt.args.profile.Validate(tt.args.fields)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error validation profile: %v", err)
+			}
+		})
+	}
+}
@@ -27,9 +27,21 @@ import (
 type Session struct {
 	Id           ksuid.KSUID
 	UserId       ksuid.KSUID
+	FamilyId     ksuid.KSUID
 	RefreshToken string
 	Ip           string
 	ExpiresIn    time.Time
+	Rotated      bool
+}
+
+// User access token structure.
+type AccessToken struct {
+	Id        ksuid.KSUID
+	UserId    ksuid.KSUID
+	SessionId ksuid.KSUID
+	ExpiresAt time.Time
+	Roles     []string
+	Scopes    []string
 }
 
 // Authorization user tokens.
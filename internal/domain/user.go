@@ -18,6 +18,8 @@
 package domain
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/segmentio/ksuid"
@@ -32,6 +34,26 @@ type User struct {
 	LastVisit time.Time
 	Verified  bool
 	AvatarUrl *string
+	// Time of the account deletion, nil if the account is not deleted.
+	DeletedAt *time.Time
+}
+
+// User email address change revert structure.
+type EmailRevert struct {
+	UserId ksuid.KSUID
+	// Email address before the change.
+	Email string
+}
+
+// User search filter structure.
+type UserSearch struct {
+	// Username prefix or similar username, all users are matched if empty.
+	Query string
+	// Verified status, all users are matched if nil.
+	Verified *bool
+	// Only users created before the user with this id are matched if not nil.
+	Before ksuid.KSUID
+	Limit  int
 }
 
 // Validate user.
@@ -47,3 +69,37 @@ func (u User) Validate() error {
 
 	return nil
 }
+
+// Validate user avatar url against allowed schemes, hosts and length.
+func ValidateAvatarUrl(avatarUrl string, schemes, hosts []string, maxLength int) error {
+	invalid := &Error{Code: CodeInvalidArgument, Message: "Invalid Avatar Url"}
+
+	// Check avatar url length.
+	if avatarUrl == "" || len(avatarUrl) > maxLength {
+		return invalid
+	}
+
+	// Parsing avatar url.
+	u, err := url.Parse(avatarUrl)
+	if err != nil || u.User != nil {
+		return invalid
+	}
+
+	// Check avatar url scheme and host.
+	if !contains(schemes, u.Scheme) || !contains(hosts, u.Hostname()) {
+		return invalid
+	}
+
+	return nil
+}
+
+// Check if the list contains the value, ignoring case.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -17,7 +17,10 @@
 
 package domain
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 // Testing validate user.
 func TestUser_Validate(t *testing.T) {
@@ -72,3 +75,55 @@ func TestUser_Validate(t *testing.T) {
 		})
 	}
 }
+
+// Testing validate user avatar url.
+func TestValidateAvatarUrl(t *testing.T) {
+	// Tests structures.
+	tests := []struct {
+		name      string
+		avatarUrl string
+		wantErr   bool
+	}{
+		{
+			name:      "OK",
+			avatarUrl: "https://cdn.durudex.com/avatar/0ujzPyRiIAffKhBux4PvQdDqMHY/user.png",
+			wantErr:   false,
+		},
+		{
+			name:      "Empty",
+			avatarUrl: "",
+			wantErr:   true,
+		},
+		{
+			name:      "Invalid Scheme",
+			avatarUrl: "http://cdn.durudex.com/avatar/user.png",
+			wantErr:   true,
+		},
+		{
+			name:      "Invalid Host",
+			avatarUrl: "https://example.com/avatar/user.png",
+			wantErr:   true,
+		},
+		{
+			name:      "User Info",
+			avatarUrl: "https://cdn.durudex.com@example.com/avatar/user.png",
+			wantErr:   true,
+		},
+		{
+			name:      "Too Long",
+			avatarUrl: "https://cdn.durudex.com/" + strings.Repeat("a", 255),
+			wantErr:   true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Validate user avatar url.
+			err := ValidateAvatarUrl(tt.avatarUrl, []string{"https"}, []string{"cdn.durudex.com"}, 255)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error validation avatar url: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/segmentio/ksuid"
+)
+
+// Credential table name.
+const CredentialTable string = "user_credential"
+
+// User WebAuthn credential repository interface.
+type Credential interface {
+	Create(ctx context.Context, credential domain.Credential) error
+	Get(ctx context.Context, id []byte) (domain.Credential, error)
+	GetAll(ctx context.Context, userId ksuid.KSUID) ([]domain.Credential, error)
+	UpdateSignCount(ctx context.Context, id []byte, signCount uint32) error
+}
+
+// User WebAuthn credential repository structure.
+type CredentialRepository struct{ psql postgres.Postgres }
+
+// Creating a new user WebAuthn credential repository.
+func NewCredentialRepository(psql postgres.Postgres) *CredentialRepository {
+	return &CredentialRepository{psql: psql}
+}
+
+// Creating a new user WebAuthn credential in postgres database.
+func (r *CredentialRepository) Create(ctx context.Context, credential domain.Credential) error {
+	// Query to create a new user WebAuthn credential.
+	query := fmt.Sprintf(`INSERT INTO "%s" (id, user_id, public_key, sign_count)
+		VALUES ($1, $2, $3, $4)`, CredentialTable)
+	_, err := r.psql.Exec(ctx, query, credential.Id, credential.UserId, credential.PublicKey,
+		credential.SignCount)
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		// Check if the credential is already registered.
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return &domain.Error{Code: domain.CodeAlreadyExists, Message: "Credential already exists"}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Getting a user WebAuthn credential by id in postgres database.
+func (r *CredentialRepository) Get(ctx context.Context, id []byte) (domain.Credential, error) {
+	credential := domain.Credential{Id: id}
+
+	// Query to get user WebAuthn credential by id.
+	query := fmt.Sprintf(`SELECT user_id, public_key, sign_count FROM "%s" WHERE id=$1`,
+		CredentialTable)
+	row := r.psql.QueryRow(ctx, query, id)
+
+	// Scanning query row.
+	if err := row.Scan(&credential.UserId, &credential.PublicKey, &credential.SignCount); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Credential{}, &domain.Error{Code: domain.CodeNotFound, Message: "Credential not found"}
+		}
+
+		return domain.Credential{}, err
+	}
+
+	return credential, nil
+}
+
+// Getting all user WebAuthn credentials in postgres database.
+func (r *CredentialRepository) GetAll(ctx context.Context, userId ksuid.KSUID) ([]domain.Credential, error) {
+	// Query to get all user WebAuthn credentials.
+	query := fmt.Sprintf(`SELECT id, public_key, sign_count FROM "%s" WHERE user_id=$1
+		ORDER BY created_at`, CredentialTable)
+	rows, err := r.psql.Query(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]domain.Credential, 0)
+
+	// Scanning query rows.
+	for rows.Next() {
+		credential := domain.Credential{UserId: userId}
+
+		if err := rows.Scan(&credential.Id, &credential.PublicKey, &credential.SignCount); err != nil {
+			return nil, err
+		}
+
+		credentials = append(credentials, credential)
+	}
+
+	return credentials, rows.Err()
+}
+
+// Updating a user WebAuthn credential signature counter in postgres database.
+func (r *CredentialRepository) UpdateSignCount(ctx context.Context, id []byte, signCount uint32) error {
+	// Query to update the signature counter, it must grow unless the authenticator does not
+	// support counters.
+	query := fmt.Sprintf(`UPDATE "%s" SET sign_count=$2, last_used_at=now()
+		WHERE id=$1 AND (sign_count < $2 OR (sign_count = 0 AND $2 = 0))`, CredentialTable)
+	tag, err := r.psql.Exec(ctx, query, id, signCount)
+	if err != nil {
+		return err
+	}
+
+	// Check if the authenticator may have been cloned.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Signature Counter"}
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing creating a new user WebAuthn credential in postgres database.
+func TestCredentialRepository_Create(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ credential domain.Credential }
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewCredentialRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{credential: domain.Credential{
+				Id:        []byte("credential"),
+				UserId:    ksuid.New(),
+				PublicKey: []byte("public-key"),
+				SignCount: 1,
+			}},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.CredentialTable)).
+					WithArgs(args.credential.Id, args.credential.UserId, args.credential.PublicKey,
+						args.credential.SignCount).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Creating a new user WebAuthn credential in postgres database.
+			err := repos.Create(context.Background(), tt.args.credential)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error creating user credential: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing getting a user WebAuthn credential in postgres database.
+func TestCredentialRepository_Get(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ id []byte }
+
+	// Test behavior.
+	type mockBehavior func(args args, credential domain.Credential)
+
+	// Creating a new repository.
+	repos := postgres.NewCredentialRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         domain.Credential
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: []byte("credential")},
+			want: domain.Credential{
+				Id:        []byte("credential"),
+				UserId:    ksuid.New(),
+				PublicKey: []byte("public-key"),
+				SignCount: 1,
+			},
+			mockBehavior: func(args args, credential domain.Credential) {
+				rows := mock.NewRows([]string{"user_id", "public_key", "sign_count"}).
+					AddRow(credential.UserId, credential.PublicKey, credential.SignCount)
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.CredentialTable)).
+					WithArgs(args.id).
+					WillReturnRows(rows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting a user WebAuthn credential in postgres database.
+			got, err := repos.Get(context.Background(), tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting user credential: %s", err.Error())
+			}
+
+			// Check for similarity of user credential.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error user credential are not similar")
+			}
+		})
+	}
+}
+
+// Testing getting all user WebAuthn credentials in postgres database.
+func TestCredentialRepository_GetAll(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ userId ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, credentials []domain.Credential)
+
+	// Creating a new repository.
+	repos := postgres.NewCredentialRepository(mock)
+
+	userId := ksuid.New()
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         []domain.Credential
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: userId},
+			want: []domain.Credential{
+				{Id: []byte("first"), UserId: userId, PublicKey: []byte("public-key"), SignCount: 1},
+				{Id: []byte("second"), UserId: userId, PublicKey: []byte("public-key"), SignCount: 0},
+			},
+			mockBehavior: func(args args, credentials []domain.Credential) {
+				rows := mock.NewRows([]string{"id", "public_key", "sign_count"})
+				for _, c := range credentials {
+					rows.AddRow(c.Id, c.PublicKey, c.SignCount)
+				}
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.CredentialTable)).
+					WithArgs(args.userId).
+					WillReturnRows(rows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting all user WebAuthn credentials in postgres database.
+			got, err := repos.GetAll(context.Background(), tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting user credentials: %s", err.Error())
+			}
+
+			// Check for similarity of user credentials.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error user credentials are not similar")
+			}
+		})
+	}
+}
+
+// Testing updating a user WebAuthn credential signature counter in postgres database.
+func TestCredentialRepository_UpdateSignCount(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id        []byte
+		signCount uint32
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewCredentialRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: []byte("credential"), signCount: 2},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.CredentialTable)).
+					WithArgs(args.id, args.signCount).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:    "Cloned Authenticator",
+			args:    args{id: []byte("credential"), signCount: 1},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.CredentialTable)).
+					WithArgs(args.id, args.signCount).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Updating a user WebAuthn credential signature counter in postgres database.
+			err := repos.UpdateSignCount(context.Background(), tt.args.id, tt.args.signCount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error updating credential sign count: %s", err.Error())
+			}
+		})
+	}
+}
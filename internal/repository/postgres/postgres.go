@@ -28,6 +28,10 @@ import (
 type PostgresRepository struct {
 	User
 	Session
+	TOTP
+	RecoveryCode
+	Credential
+	Profile
 }
 
 // Creating a new postgres repository.
@@ -45,7 +49,11 @@ func NewPostgresRepository(cfg config.PostgresConfig) *PostgresRepository {
 	}
 
 	return &PostgresRepository{
-		User:    NewUserRepository(client),
-		Session: NewSessionRepository(client),
+		User:         NewUserRepository(client),
+		Session:      NewSessionRepository(client),
+		TOTP:         NewTOTPRepository(client),
+		RecoveryCode: NewRecoveryCodeRepository(client),
+		Credential:   NewCredentialRepository(client),
+		Profile:      NewProfileRepository(client),
 	}
 }
@@ -0,0 +1,134 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/segmentio/ksuid"
+)
+
+// User profile table name.
+const UserProfileTable string = "user_profile"
+
+// User profile repository interface.
+type Profile interface {
+	Get(ctx context.Context, userId ksuid.KSUID) (domain.Profile, error)
+	Update(ctx context.Context, userId ksuid.KSUID, profile domain.Profile, fields []string) error
+}
+
+// User profile repository structure.
+type ProfileRepository struct{ psql postgres.Postgres }
+
+// Creating a new user profile repository.
+func NewProfileRepository(psql postgres.Postgres) *ProfileRepository {
+	return &ProfileRepository{psql: psql}
+}
+
+// Getting user profile, a user without a stored profile has an empty profile.
+func (r *ProfileRepository) Get(ctx context.Context, userId ksuid.KSUID) (domain.Profile, error) {
+	var profile domain.Profile
+
+	// Query for get user profile.
+	query := fmt.Sprintf(`SELECT COALESCE(p."display_name", ''), COALESCE(p."bio", ''),
+		COALESCE(p."locale", ''), COALESCE(p."timezone", ''), COALESCE(p."links", '{}')
+		FROM "%s" u LEFT JOIN "%s" p ON p."user_id"=u."id" WHERE u."id"=$1 AND u."deleted_at" IS NULL`,
+		UserTable, UserProfileTable)
+
+	row := r.psql.QueryRow(ctx, query, userId)
+
+	// Scanning query row.
+	if err := row.Scan(&profile.DisplayName, &profile.Bio, &profile.Locale, &profile.Timezone,
+		&profile.Links); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Profile{}, &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+		}
+
+		return domain.Profile{}, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return profile, nil
+}
+
+// Updating user profile fields, all fields are updated if fields are empty.
+func (r *ProfileRepository) Update(ctx context.Context, userId ksuid.KSUID, profile domain.Profile, fields []string) error {
+	// Check if all fields should be updated.
+	if len(fields) == 0 {
+		fields = domain.ProfileFields
+	}
+
+	columns := make([]string, 0, len(fields))
+	params := make([]string, 0, len(fields))
+	updates := make([]string, 0, len(fields))
+	args := []interface{}{userId}
+
+	for _, field := range fields {
+		var value interface{}
+
+		// Getting profile field value, field names are the column names.
+		switch field {
+		case domain.ProfileDisplayName:
+			value = profile.DisplayName
+		case domain.ProfileBio:
+			value = profile.Bio
+		case domain.ProfileLocale:
+			value = profile.Locale
+		case domain.ProfileTimezone:
+			value = profile.Timezone
+		case domain.ProfileLinks:
+			value = profile.Links
+			if profile.Links == nil {
+				value = []string{}
+			}
+		default:
+			return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Profile Field"}
+		}
+
+		args = append(args, value)
+		columns = append(columns, fmt.Sprintf(`"%s"`, field))
+		params = append(params, fmt.Sprintf("$%d", len(args)))
+		updates = append(updates, fmt.Sprintf(`"%s"=EXCLUDED."%s"`, field, field))
+	}
+
+	// Query to create or update user profile.
+	query := fmt.Sprintf(`INSERT INTO "%s" ("user_id", %s) VALUES ($1, %s)
+		ON CONFLICT ("user_id") DO UPDATE SET %s, "updated_at"=now()`, UserProfileTable,
+		strings.Join(columns, ", "), strings.Join(params, ", "), strings.Join(updates, ", "))
+
+	if _, err := r.psql.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+
+		// Check if user is not found.
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+		}
+
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return nil
+}
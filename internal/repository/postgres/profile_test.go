@@ -0,0 +1,221 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing getting user profile in postgres database.
+func TestProfileRepository_Get(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ userId ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, profile domain.Profile)
+
+	// Creating a new repository.
+	repos := postgres.NewProfileRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         domain.Profile
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New()},
+			want: domain.Profile{
+				DisplayName: "Durudex",
+				Bio:         "Bio",
+				Locale:      "en-US",
+				Timezone:    "Europe/Kiev",
+				Links:       []string{"https://durudex.com"},
+			},
+			mockBehavior: func(args args, profile domain.Profile) {
+				rows := mock.NewRows([]string{
+					"display_name", "bio", "locale", "timezone", "links",
+				}).AddRow(profile.DisplayName, profile.Bio, profile.Locale, profile.Timezone, profile.Links)
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s" u LEFT JOIN "%s"`, postgres.UserTable,
+					postgres.UserProfileTable)).
+					WithArgs(args.userId).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{userId: ksuid.New()},
+			want:    domain.Profile{},
+			wantErr: true,
+			mockBehavior: func(args args, profile domain.Profile) {
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s" u LEFT JOIN "%s"`, postgres.UserTable,
+					postgres.UserProfileTable)).
+					WithArgs(args.userId).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting user profile.
+			got, err := repos.Get(context.Background(), tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting user profile: %v", err)
+			}
+
+			// Check for similarity of user profile.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error user profiles are not similar")
+			}
+		})
+	}
+}
+
+// Testing updating user profile in postgres database.
+func TestProfileRepository_Update(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		userId  ksuid.KSUID
+		profile domain.Profile
+		fields  []string
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewProfileRepository(mock)
+
+	profile := domain.Profile{
+		DisplayName: "Durudex",
+		Bio:         "Bio",
+		Locale:      "en-US",
+		Timezone:    "Europe/Kiev",
+		Links:       []string{"https://durudex.com"},
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), profile: profile},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s" \("user_id", "display_name", "bio", "locale", "timezone", "links"\)`,
+					postgres.UserProfileTable)).
+					WithArgs(args.userId, args.profile.DisplayName, args.profile.Bio, args.profile.Locale,
+						args.profile.Timezone, args.profile.Links).
+					WillReturnResult(pgxmock.NewResult("", 1))
+			},
+		},
+		{
+			name: "Field Mask",
+			args: args{
+				userId:  ksuid.New(),
+				profile: profile,
+				fields:  []string{domain.ProfileBio, domain.ProfileTimezone},
+			},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s" \("user_id", "bio", "timezone"\) VALUES \(\$1, \$2, \$3\)`,
+					postgres.UserProfileTable)).
+					WithArgs(args.userId, args.profile.Bio, args.profile.Timezone).
+					WillReturnResult(pgxmock.NewResult("", 1))
+			},
+		},
+		{
+			name: "Unknown Field",
+			args: args{
+				userId:  ksuid.New(),
+				profile: profile,
+				fields:  []string{"email"},
+			},
+			wantErr:      true,
+			mockBehavior: func(args args) {},
+		},
+		{
+			name: "Not Found",
+			args: args{
+				userId:  ksuid.New(),
+				profile: profile,
+				fields:  []string{domain.ProfileBio},
+			},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.UserProfileTable)).
+					WithArgs(args.userId, args.profile.Bio).
+					WillReturnError(&pgconn.PgError{Code: pgerrcode.ForeignKeyViolation})
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Updating user profile.
+			err := repos.Update(context.Background(), tt.args.userId, tt.args.profile, tt.args.fields)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error updating user profile: %v", err)
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error expectations were not met: %s", err.Error())
+			}
+		})
+	}
+}
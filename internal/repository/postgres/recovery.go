@@ -0,0 +1,104 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/postgres"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Recovery code table name.
+const RecoveryCodeTable string = "user_recovery_code"
+
+// User recovery code repository interface.
+type RecoveryCode interface {
+	Replace(ctx context.Context, userId ksuid.KSUID, codes []string) error
+	Use(ctx context.Context, userId ksuid.KSUID, code string) (int, error)
+}
+
+// User recovery code repository structure.
+type RecoveryCodeRepository struct{ psql postgres.Postgres }
+
+// Creating a new user recovery code repository.
+func NewRecoveryCodeRepository(psql postgres.Postgres) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{psql: psql}
+}
+
+// Replacing all user recovery codes in postgres database.
+func (r *RecoveryCodeRepository) Replace(ctx context.Context, userId ksuid.KSUID, codes []string) error {
+	// Starting a new transaction.
+	tx, err := r.psql.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// Rollback the transaction if it has not been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Query to delete all previous user recovery codes.
+	query := fmt.Sprintf(`DELETE FROM "%s" WHERE user_id=$1`, RecoveryCodeTable)
+	if _, err := tx.Exec(ctx, query, userId); err != nil {
+		return err
+	}
+
+	// Query to set new user recovery codes.
+	query = fmt.Sprintf(`INSERT INTO "%s" (user_id, code) SELECT $1, unnest($2::varchar[])`,
+		RecoveryCodeTable)
+	if _, err := tx.Exec(ctx, query, userId, codes); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Using a user recovery code in postgres database and getting the number of remaining codes.
+func (r *RecoveryCodeRepository) Use(ctx context.Context, userId ksuid.KSUID, code string) (int, error) {
+	var remaining int
+
+	// Starting a new transaction.
+	tx, err := r.psql.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// Rollback the transaction if it has not been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Query to delete the used recovery code.
+	query := fmt.Sprintf(`DELETE FROM "%s" WHERE user_id=$1 AND code=$2`, RecoveryCodeTable)
+	tag, err := tx.Exec(ctx, query, userId, code)
+	if err != nil {
+		return 0, err
+	}
+
+	// Check if the recovery code does not exist or has already been used.
+	if tag.RowsAffected() == 0 {
+		return 0, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Code"}
+	}
+
+	// Query to count remaining user recovery codes.
+	query = fmt.Sprintf(`SELECT count(*) FROM "%s" WHERE user_id=$1`, RecoveryCodeTable)
+	if err := tx.QueryRow(ctx, query, userId).Scan(&remaining); err != nil {
+		return 0, err
+	}
+
+	return remaining, tx.Commit(ctx)
+}
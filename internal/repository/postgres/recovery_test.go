@@ -0,0 +1,174 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing replacing user recovery codes in postgres database.
+func TestRecoveryCodeRepository_Replace(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		userId ksuid.KSUID
+		codes  []string
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewRecoveryCodeRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), codes: []string{"qwerty", "asdfgh"}},
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.RecoveryCodeTable)).
+					WithArgs(args.userId).
+					WillReturnResult(pgxmock.NewResult("DELETE", 2))
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.RecoveryCodeTable)).
+					WithArgs(args.userId, args.codes).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Replacing user recovery codes in postgres database.
+			err := repos.Replace(context.Background(), tt.args.userId, tt.args.codes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error replacing user recovery codes: %v", err)
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error unfulfilled expectations: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing using a user recovery code in postgres database.
+func TestRecoveryCodeRepository_Use(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		userId ksuid.KSUID
+		code   string
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args, remaining int)
+
+	// Creating a new repository.
+	repos := postgres.NewRecoveryCodeRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         int
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), code: "qwerty"},
+			want: 9,
+			mockBehavior: func(args args, remaining int) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.RecoveryCodeTable)).
+					WithArgs(args.userId, args.code).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectQuery(fmt.Sprintf(`SELECT count\(\*\) FROM "%s"`, postgres.RecoveryCodeTable)).
+					WithArgs(args.userId).
+					WillReturnRows(mock.NewRows([]string{"count"}).AddRow(remaining))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Invalid Code",
+			args:    args{userId: ksuid.New(), code: "qwerty"},
+			wantErr: true,
+			mockBehavior: func(args args, remaining int) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.RecoveryCodeTable)).
+					WithArgs(args.userId, args.code).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Using a user recovery code in postgres database.
+			got, err := repos.Use(context.Background(), tt.args.userId, tt.args.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error using user recovery code: %v", err)
+			}
+
+			// Check for similarity of remaining codes.
+			if got != tt.want {
+				t.Errorf("error remaining codes are not similar: %d", got)
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error unfulfilled expectations: %s", err.Error())
+			}
+		})
+	}
+}
@@ -19,11 +19,13 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/pkg/database/postgres"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/segmentio/ksuid"
 )
 
@@ -33,8 +35,12 @@ const SessionTable string = "user_session"
 // User session repository interface.
 type Session interface {
 	Create(ctx context.Context, session domain.Session) error
-	GetUserId(ctx context.Context, refreshToken, ip string) (ksuid.KSUID, error)
-	Delete(ctx context.Context, refreshToken, ip string) error
+	Get(ctx context.Context, refreshToken, ip string) (domain.Session, error)
+	GetAll(ctx context.Context, userId ksuid.KSUID) ([]domain.Session, error)
+	Rotate(ctx context.Context, id ksuid.KSUID, session domain.Session) error
+	DeleteByID(ctx context.Context, id, userId ksuid.KSUID) error
+	DeleteAll(ctx context.Context, userId, except ksuid.KSUID) ([]ksuid.KSUID, error)
+	DeleteFamily(ctx context.Context, familyId ksuid.KSUID) error
 }
 
 // User session repository structure.
@@ -48,34 +54,147 @@ func NewSessionRepository(psql postgres.Postgres) *SessionRepository {
 // Creating a new user session in postgres database.
 func (r *SessionRepository) Create(ctx context.Context, session domain.Session) error {
 	// Query to set a new user session in the postgres database.
-	query := fmt.Sprintf(`INSERT INTO "%s" (id, user_id, refresh_token, ip, expires_in)
-		VALUES ($1, $2, $3, $4, $5)`, SessionTable)
-	_, err := r.psql.Exec(ctx, query, session.Id, session.UserId, session.RefreshToken,
-		session.Ip, session.ExpiresIn)
+	query := fmt.Sprintf(`INSERT INTO "%s" (id, user_id, family_id, refresh_token, ip, expires_in)
+		VALUES ($1, $2, $3, $4, $5, $6)`, SessionTable)
+	_, err := r.psql.Exec(ctx, query, session.Id, session.UserId, session.FamilyId,
+		session.RefreshToken, session.Ip, session.ExpiresIn)
 
 	return err
 }
 
-// Getting user id by refresh token in postgres database.
-func (r *SessionRepository) GetUserId(ctx context.Context, refreshToken, ip string) (ksuid.KSUID, error) {
-	var id string
+// Getting a user session by refresh token in postgres database.
+func (r *SessionRepository) Get(ctx context.Context, refreshToken, ip string) (domain.Session, error) {
+	session := domain.Session{RefreshToken: refreshToken, Ip: ip}
 
-	// Query to get user id by refresh token from user session table.
-	query := fmt.Sprintf(`SELECT user_id FROM "%s" WHERE refresh_token=$1 AND expires_in > now()
-		AND ip=$2`, SessionTable)
+	// Query to get user session by refresh token from user session table.
+	query := fmt.Sprintf(`SELECT id, user_id, family_id, expires_in, rotated_at IS NOT NULL
+		FROM "%s" WHERE refresh_token=$1 AND expires_in > now() AND ip=$2`, SessionTable)
 	row := r.psql.QueryRow(ctx, query, refreshToken, ip)
-	if err := row.Scan(&id); err != nil {
-		return ksuid.Nil, err
+
+	// Scanning query row.
+	err := row.Scan(&session.Id, &session.UserId, &session.FamilyId, &session.ExpiresIn,
+		&session.Rotated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Session{}, &domain.Error{Code: domain.CodeNotFound, Message: "Session not found"}
+		}
+
+		return domain.Session{}, err
+	}
+
+	return session, nil
+}
+
+// Getting all active user sessions in postgres database.
+func (r *SessionRepository) GetAll(ctx context.Context, userId ksuid.KSUID) ([]domain.Session, error) {
+	// Query to get all active user sessions.
+	query := fmt.Sprintf(`SELECT id, family_id, host(ip), expires_in FROM "%s" WHERE user_id=$1
+		AND rotated_at IS NULL AND expires_in > now() ORDER BY family_id DESC`, SessionTable)
+	rows, err := r.psql.Query(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]domain.Session, 0)
+
+	// Scanning query rows.
+	for rows.Next() {
+		session := domain.Session{UserId: userId}
+
+		if err := rows.Scan(&session.Id, &session.FamilyId, &session.Ip, &session.ExpiresIn); err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+// Rotating a user session refresh token in postgres database.
+func (r *SessionRepository) Rotate(ctx context.Context, id ksuid.KSUID, session domain.Session) error {
+	// Starting a new transaction.
+	tx, err := r.psql.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// Rollback the transaction if it has not been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Query to mark the current user session refresh token as rotated.
+	query := fmt.Sprintf(`UPDATE "%s" SET rotated_at=now() WHERE id=$1 AND rotated_at IS NULL`,
+		SessionTable)
+	tag, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	// Check if the refresh token has already been rotated by another request.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeUnauthenticated, Message: "Refresh token reused"}
+	}
+
+	// Query to set a new user session in the same family.
+	query = fmt.Sprintf(`INSERT INTO "%s" (id, user_id, family_id, refresh_token, ip, expires_in)
+		VALUES ($1, $2, $3, $4, $5, $6)`, SessionTable)
+	if _, err := tx.Exec(ctx, query, session.Id, session.UserId, session.FamilyId,
+		session.RefreshToken, session.Ip, session.ExpiresIn); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Deleting a user session by id in postgres database.
+func (r *SessionRepository) DeleteByID(ctx context.Context, id, userId ksuid.KSUID) error {
+	// Query to deleting user session family by id.
+	query := fmt.Sprintf(`DELETE FROM "%s" WHERE family_id=$1 AND user_id=$2`, SessionTable)
+	tag, err := r.psql.Exec(ctx, query, id, userId)
+	if err != nil {
+		return err
+	}
+
+	// Check if session has been deleted.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "Session not found"}
+	}
+
+	return nil
+}
+
+// Deleting all user sessions except one in postgres database.
+func (r *SessionRepository) DeleteAll(ctx context.Context, userId, except ksuid.KSUID) ([]ksuid.KSUID, error) {
+	// Query to deleting all user session families except one and returning deleted families.
+	query := fmt.Sprintf(`WITH deleted AS (DELETE FROM "%s" WHERE user_id=$1 AND family_id<>$2
+		RETURNING family_id, rotated_at) SELECT family_id FROM deleted WHERE rotated_at IS NULL`, SessionTable)
+	rows, err := r.psql.Query(ctx, query, userId, except)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	families := make([]ksuid.KSUID, 0)
+
+	// Scanning deleted session families.
+	for rows.Next() {
+		var familyId ksuid.KSUID
+
+		if err := rows.Scan(&familyId); err != nil {
+			return nil, err
+		}
+
+		families = append(families, familyId)
 	}
 
-	return ksuid.Parse(id)
+	return families, rows.Err()
 }
 
-// Deleting a user session in postgres database.
-func (r *SessionRepository) Delete(ctx context.Context, refreshToken, ip string) error {
-	// Query to deleting user session by refresh token.
-	query := fmt.Sprintf(`DELETE FROM "%s" WHERE refresh_token=$1 AND ip=$2`, SessionTable)
-	_, err := r.psql.Exec(ctx, query, refreshToken, ip)
+// Deleting a user session family in postgres database.
+func (r *SessionRepository) DeleteFamily(ctx context.Context, familyId ksuid.KSUID) error {
+	// Query to deleting all user sessions in the family.
+	query := fmt.Sprintf(`DELETE FROM "%s" WHERE family_id=$1`, SessionTable)
+	_, err := r.psql.Exec(ctx, query, familyId)
 
 	return err
 }
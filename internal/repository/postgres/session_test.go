@@ -61,6 +61,7 @@ func TestSessionRepository_Create(t *testing.T) {
 			args: args{domain.Session{
 				Id:           ksuid.New(),
 				UserId:       ksuid.New(),
+				FamilyId:     ksuid.New(),
 				RefreshToken: "qwerty",
 				Ip:           "0.0.0.0",
 				ExpiresIn:    time.Now(),
@@ -68,8 +69,8 @@ func TestSessionRepository_Create(t *testing.T) {
 			mockBehavior: func(args args) {
 				query := fmt.Sprintf(`INSERT INTO "%s"`, postgres.SessionTable)
 				mock.ExpectExec(query).
-					WithArgs(args.session.Id, args.session.UserId, args.session.RefreshToken,
-						args.session.Ip, args.session.ExpiresIn).
+					WithArgs(args.session.Id, args.session.UserId, args.session.FamilyId,
+						args.session.RefreshToken, args.session.Ip, args.session.ExpiresIn).
 					WillReturnResult(pgxmock.NewResult("", 1))
 			},
 		},
@@ -89,8 +90,8 @@ func TestSessionRepository_Create(t *testing.T) {
 	}
 }
 
-// Testing getting user id by refresh token in postgres database.
-func TestSessionRepository_GetUserId(t *testing.T) {
+// Testing getting a user session by refresh token in postgres database.
+func TestSessionRepository_Get(t *testing.T) {
 	// Creating a new mock connection.
 	mock, err := pgxmock.NewConn()
 	if err != nil {
@@ -102,7 +103,7 @@ func TestSessionRepository_GetUserId(t *testing.T) {
 	type args struct{ refreshToken, ip string }
 
 	// Test behavior.
-	type mockBehavior func(args args, id ksuid.KSUID)
+	type mockBehavior func(args args, session domain.Session)
 
 	// Creating a new repository.
 	repos := postgres.NewSessionRepository(mock)
@@ -111,19 +112,32 @@ func TestSessionRepository_GetUserId(t *testing.T) {
 	tests := []struct {
 		name         string
 		args         args
-		want         ksuid.KSUID
+		want         domain.Session
 		wantErr      bool
 		mockBehavior mockBehavior
 	}{
 		{
 			name: "OK",
 			args: args{refreshToken: "qwerty", ip: "0.0.0.0"},
-			want: ksuid.New(),
-			mockBehavior: func(args args, id ksuid.KSUID) {
+			want: domain.Session{
+				Id:           ksuid.New(),
+				UserId:       ksuid.New(),
+				FamilyId:     ksuid.New(),
+				RefreshToken: "qwerty",
+				Ip:           "0.0.0.0",
+				ExpiresIn:    time.Now(),
+				Rotated:      true,
+			},
+			mockBehavior: func(args args, session domain.Session) {
+				rows := mock.NewRows([]string{
+					"id", "user_id", "family_id", "expires_in", "rotated",
+				}).AddRow(session.Id.String(), session.UserId.String(), session.FamilyId.String(),
+					session.ExpiresIn, session.Rotated)
+
 				query := fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.SessionTable)
 				mock.ExpectQuery(query).
 					WithArgs(args.refreshToken, args.ip).
-					WillReturnRows(mock.NewRows([]string{"id"}).AddRow(id.String()))
+					WillReturnRows(rows)
 			},
 		},
 	}
@@ -133,22 +147,22 @@ func TestSessionRepository_GetUserId(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockBehavior(tt.args, tt.want)
 
-			// Get user id by refresh token in postgres database.
-			got, err := repos.GetUserId(context.Background(), tt.args.refreshToken, tt.args.ip)
+			// Getting a user session by refresh token in postgres database.
+			got, err := repos.Get(context.Background(), tt.args.refreshToken, tt.args.ip)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("error getting user id by refresh token: %s", err.Error())
+				t.Errorf("error getting user session by refresh token: %s", err.Error())
 			}
 
-			// Check for similarity of user id.
+			// Check for similarity of user session.
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Error("error user id are not similar")
+				t.Error("error user sessions are not similar")
 			}
 		})
 	}
 }
 
-// Testing deleting a user session in postgres database.
-func TestSessionRepository_Delete(t *testing.T) {
+// Testing getting all active user sessions in postgres database.
+func TestSessionRepository_GetAll(t *testing.T) {
 	// Creating a new mock connection.
 	mock, err := pgxmock.NewConn()
 	if err != nil {
@@ -157,7 +171,171 @@ func TestSessionRepository_Delete(t *testing.T) {
 	defer mock.Close(context.Background())
 
 	// Testing args.
-	type args struct{ refreshToken, ip string }
+	type args struct{ userId ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, sessions []domain.Session)
+
+	// Creating a new repository.
+	repos := postgres.NewSessionRepository(mock)
+
+	userId := ksuid.New()
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         []domain.Session
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: userId},
+			want: []domain.Session{
+				{Id: ksuid.New(), UserId: userId, FamilyId: ksuid.New(), Ip: "0.0.0.0", ExpiresIn: time.Now()},
+				{Id: ksuid.New(), UserId: userId, FamilyId: ksuid.New(), Ip: "0.0.0.1", ExpiresIn: time.Now()},
+			},
+			mockBehavior: func(args args, sessions []domain.Session) {
+				rows := mock.NewRows([]string{"id", "family_id", "ip", "expires_in"})
+
+				for _, session := range sessions {
+					rows.AddRow(session.Id.String(), session.FamilyId.String(), session.Ip,
+						session.ExpiresIn)
+				}
+
+				query := fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.SessionTable)
+				mock.ExpectQuery(query).
+					WithArgs(args.userId).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Empty",
+			args: args{userId: userId},
+			want: []domain.Session{},
+			mockBehavior: func(args args, sessions []domain.Session) {
+				query := fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.SessionTable)
+				mock.ExpectQuery(query).
+					WithArgs(args.userId).
+					WillReturnRows(mock.NewRows([]string{"id", "family_id", "ip", "expires_in"}))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting all active user sessions in postgres database.
+			got, err := repos.GetAll(context.Background(), tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting user sessions: %s", err.Error())
+			}
+
+			// Check for similarity of user sessions.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error user sessions are not similar")
+			}
+		})
+	}
+}
+
+// Testing rotating a user session refresh token in postgres database.
+func TestSessionRepository_Rotate(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id      ksuid.KSUID
+		session domain.Session
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewSessionRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New(), session: domain.Session{
+				Id:           ksuid.New(),
+				UserId:       ksuid.New(),
+				FamilyId:     ksuid.New(),
+				RefreshToken: "qwerty",
+				Ip:           "0.0.0.0",
+				ExpiresIn:    time.Now(),
+			}},
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.SessionTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.SessionTable)).
+					WithArgs(args.session.Id, args.session.UserId, args.session.FamilyId,
+						args.session.RefreshToken, args.session.Ip, args.session.ExpiresIn).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Already Rotated",
+			args:    args{id: ksuid.New(), session: domain.Session{Id: ksuid.New()}},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.SessionTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Rotating a user session refresh token in postgres database.
+			err := repos.Rotate(context.Background(), tt.args.id, tt.args.session)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error rotating user session: %v", err)
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error unfulfilled expectations: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing deleting a user session by id in postgres database.
+func TestSessionRepository_DeleteByID(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ id, userId ksuid.KSUID }
 
 	// Test behavior.
 	type mockBehavior func(args args)
@@ -173,14 +351,148 @@ func TestSessionRepository_Delete(t *testing.T) {
 		mockBehavior mockBehavior
 	}{
 		{
-			name:    "OK",
-			args:    args{refreshToken: "qwerty", ip: "0.0.0.0"},
-			wantErr: false,
+			name: "OK",
+			args: args{id: ksuid.New(), userId: ksuid.New()},
 			mockBehavior: func(args args) {
 				query := fmt.Sprintf(`DELETE FROM "%s"`, postgres.SessionTable)
 				mock.ExpectExec(query).
-					WithArgs(args.refreshToken, args.ip).
-					WillReturnResult(pgxmock.NewResult("", 1))
+					WithArgs(args.id, args.userId).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{id: ksuid.New(), userId: ksuid.New()},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				query := fmt.Sprintf(`DELETE FROM "%s"`, postgres.SessionTable)
+				mock.ExpectExec(query).
+					WithArgs(args.id, args.userId).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Deleting a user session by id in postgres database.
+			err := repos.DeleteByID(context.Background(), tt.args.id, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error deleting user session by id: %v", err)
+			}
+		})
+	}
+}
+
+// Testing deleting all user sessions in postgres database.
+func TestSessionRepository_DeleteAll(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ userId, except ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, want []ksuid.KSUID)
+
+	// Creating a new repository.
+	repos := postgres.NewSessionRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         []ksuid.KSUID
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), except: ksuid.Nil},
+			want: []ksuid.KSUID{ksuid.New(), ksuid.New(), ksuid.New()},
+			mockBehavior: func(args args, want []ksuid.KSUID) {
+				rows := mock.NewRows([]string{"family_id"}).AddRow(want[0]).AddRow(want[1]).AddRow(want[2])
+
+				query := fmt.Sprintf(`WITH deleted AS \(DELETE FROM "%s"`, postgres.SessionTable)
+				mock.ExpectQuery(query).
+					WithArgs(args.userId, args.except).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Except Current",
+			args: args{userId: ksuid.New(), except: ksuid.New()},
+			want: []ksuid.KSUID{ksuid.New()},
+			mockBehavior: func(args args, want []ksuid.KSUID) {
+				rows := mock.NewRows([]string{"family_id"}).AddRow(want[0])
+
+				query := fmt.Sprintf(`WITH deleted AS \(DELETE FROM "%s"`, postgres.SessionTable)
+				mock.ExpectQuery(query).
+					WithArgs(args.userId, args.except).
+					WillReturnRows(rows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Deleting all user sessions in postgres database.
+			got, err := repos.DeleteAll(context.Background(), tt.args.userId, tt.args.except)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error deleting all user sessions: %s", err.Error())
+			}
+
+			// Check for similarity of deleted session families.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error session families are not similar")
+			}
+		})
+	}
+}
+
+// Testing deleting a user session family in postgres database.
+func TestSessionRepository_DeleteFamily(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ familyId ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewSessionRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{familyId: ksuid.New()},
+			mockBehavior: func(args args) {
+				query := fmt.Sprintf(`DELETE FROM "%s"`, postgres.SessionTable)
+				mock.ExpectExec(query).
+					WithArgs(args.familyId).
+					WillReturnResult(pgxmock.NewResult("DELETE", 2))
 			},
 		},
 	}
@@ -190,10 +502,10 @@ func TestSessionRepository_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockBehavior(tt.args)
 
-			// Deleting a user session in postgres database.
-			err := repos.Delete(context.Background(), tt.args.refreshToken, tt.args.ip)
+			// Deleting a user session family in postgres database.
+			err := repos.DeleteFamily(context.Background(), tt.args.familyId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("error deleting user session: %s", err.Error())
+				t.Errorf("error deleting user session family: %s", err.Error())
 			}
 		})
 	}
@@ -0,0 +1,124 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/postgres"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/segmentio/ksuid"
+)
+
+// TOTP table name.
+const TOTPTable string = "user_totp"
+
+// User TOTP authenticator repository interface.
+type TOTP interface {
+	Create(ctx context.Context, userId ksuid.KSUID, secret []byte) error
+	Get(ctx context.Context, userId ksuid.KSUID) (domain.TOTP, error)
+	Confirm(ctx context.Context, userId ksuid.KSUID, step uint64) error
+	UseStep(ctx context.Context, userId ksuid.KSUID, step uint64) error
+}
+
+// User TOTP authenticator repository structure.
+type TOTPRepository struct{ psql postgres.Postgres }
+
+// Creating a new user TOTP authenticator repository.
+func NewTOTPRepository(psql postgres.Postgres) *TOTPRepository {
+	return &TOTPRepository{psql: psql}
+}
+
+// Creating or replacing an unconfirmed user TOTP authenticator in postgres database.
+func (r *TOTPRepository) Create(ctx context.Context, userId ksuid.KSUID, secret []byte) error {
+	// Query to create a new user TOTP authenticator unless it is already confirmed.
+	query := fmt.Sprintf(`INSERT INTO "%[1]s" (user_id, secret) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET secret=$2, last_step=0, created_at=now()
+		WHERE "%[1]s".confirmed=false`, TOTPTable)
+	tag, err := r.psql.Exec(ctx, query, userId, secret)
+	if err != nil {
+		return err
+	}
+
+	// Check if the authenticator is already confirmed.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeAlreadyExists, Message: "TOTP already enabled"}
+	}
+
+	return nil
+}
+
+// Getting a user TOTP authenticator in postgres database.
+func (r *TOTPRepository) Get(ctx context.Context, userId ksuid.KSUID) (domain.TOTP, error) {
+	totp := domain.TOTP{UserId: userId}
+
+	// Query to get user TOTP authenticator by user id.
+	query := fmt.Sprintf(`SELECT secret, confirmed, last_step FROM "%s" WHERE user_id=$1`, TOTPTable)
+	row := r.psql.QueryRow(ctx, query, userId)
+
+	// Scanning query row.
+	if err := row.Scan(&totp.Secret, &totp.Confirmed, &totp.LastStep); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TOTP{}, &domain.Error{Code: domain.CodeNotFound, Message: "TOTP not found"}
+		}
+
+		return domain.TOTP{}, err
+	}
+
+	return totp, nil
+}
+
+// Confirming a user TOTP authenticator in postgres database.
+func (r *TOTPRepository) Confirm(ctx context.Context, userId ksuid.KSUID, step uint64) error {
+	// Query to confirm user TOTP authenticator.
+	query := fmt.Sprintf(`UPDATE "%s" SET confirmed=true, last_step=$2
+		WHERE user_id=$1 AND confirmed=false`, TOTPTable)
+	tag, err := r.psql.Exec(ctx, query, userId, step)
+	if err != nil {
+		return err
+	}
+
+	// Check if the authenticator is not pending confirmation.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "TOTP not found"}
+	}
+
+	return nil
+}
+
+// Using a TOTP time step, so the same code cannot be replayed.
+func (r *TOTPRepository) UseStep(ctx context.Context, userId ksuid.KSUID, step uint64) error {
+	// Query to update the last used time step only if it is newer.
+	query := fmt.Sprintf(`UPDATE "%s" SET last_step=$2
+		WHERE user_id=$1 AND confirmed=true AND last_step<$2`, TOTPTable)
+	tag, err := r.psql.Exec(ctx, query, userId, step)
+	if err != nil {
+		return err
+	}
+
+	// Check if the code has already been used.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeUnauthenticated, Message: "Code already used"}
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing creating a new user TOTP authenticator in postgres database.
+func TestTOTPRepository_Create(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		userId ksuid.KSUID
+		secret []byte
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewTOTPRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), secret: []byte("secret")},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId, args.secret).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:    "Already Enabled",
+			args:    args{userId: ksuid.New(), secret: []byte("secret")},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId, args.secret).
+					WillReturnResult(pgxmock.NewResult("INSERT", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Creating a new user TOTP authenticator in postgres database.
+			err := repos.Create(context.Background(), tt.args.userId, tt.args.secret)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error creating user totp: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing getting a user TOTP authenticator in postgres database.
+func TestTOTPRepository_Get(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ userId ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, totp domain.TOTP)
+
+	// Creating a new repository.
+	repos := postgres.NewTOTPRepository(mock)
+
+	userId := ksuid.New()
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         domain.TOTP
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: userId},
+			want: domain.TOTP{UserId: userId, Secret: []byte("secret"), Confirmed: true, LastStep: 41152263},
+			mockBehavior: func(args args, totp domain.TOTP) {
+				rows := mock.NewRows([]string{"secret", "confirmed", "last_step"}).
+					AddRow(totp.Secret, totp.Confirmed, totp.LastStep)
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId).
+					WillReturnRows(rows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting a user TOTP authenticator in postgres database.
+			got, err := repos.Get(context.Background(), tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting user totp: %s", err.Error())
+			}
+
+			// Check for similarity of user totp.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error user totp are not similar")
+			}
+		})
+	}
+}
+
+// Testing confirming a user TOTP authenticator in postgres database.
+func TestTOTPRepository_Confirm(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		userId ksuid.KSUID
+		step   uint64
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewTOTPRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), step: 41152263},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId, args.step).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{userId: ksuid.New(), step: 41152263},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId, args.step).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Confirming a user TOTP authenticator in postgres database.
+			err := repos.Confirm(context.Background(), tt.args.userId, tt.args.step)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error confirming user totp: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing using a TOTP time step in postgres database.
+func TestTOTPRepository_UseStep(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		userId ksuid.KSUID
+		step   uint64
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewTOTPRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{userId: ksuid.New(), step: 41152264},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId, args.step).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:    "Replayed Code",
+			args:    args{userId: ksuid.New(), step: 41152263},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.TOTPTable)).
+					WithArgs(args.userId, args.step).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Using a TOTP time step in postgres database.
+			err := repos.UseStep(context.Background(), tt.args.userId, tt.args.step)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error using totp step: %s", err.Error())
+			}
+		})
+	}
+}
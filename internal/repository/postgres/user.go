@@ -21,6 +21,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/pkg/database/postgres"
@@ -31,16 +33,32 @@ import (
 	"github.com/segmentio/ksuid"
 )
 
-// User table name.
-const UserTable string = "user"
+// User table names.
+const (
+	UserTable            string = "user"
+	UsernameHistoryTable string = "username_history"
+)
 
 // User repository interface.
 type User interface {
 	Create(ctx context.Context, user domain.User) error
 	GetByID(ctx context.Context, id ksuid.KSUID) (domain.User, error)
+	GetByIDs(ctx context.Context, ids []ksuid.KSUID) ([]domain.User, error)
 	GetByUsername(ctx context.Context, username string) (domain.User, error)
-	ForgotPassword(ctx context.Context, password, email string) error
-	UpdateAvatar(ctx context.Context, avatarUrl string, id ksuid.KSUID) error
+	ForgotPassword(ctx context.Context, password, email string) (ksuid.KSUID, error)
+	UpdateAvatar(ctx context.Context, avatarUrl *string, id ksuid.KSUID) error
+	UpdateLastVisit(ctx context.Context, id ksuid.KSUID) error
+	SetVerified(ctx context.Context, id ksuid.KSUID, verified bool) error
+	UpdateEmail(ctx context.Context, id ksuid.KSUID, email string) error
+	GetPassword(ctx context.Context, id ksuid.KSUID) (string, error)
+	UpdatePassword(ctx context.Context, id ksuid.KSUID, password string) error
+	UpdateUsername(ctx context.Context, id ksuid.KSUID, username string, cooldown, reservation time.Duration) error
+	ResolveUsername(ctx context.Context, username string) (ksuid.KSUID, error)
+	Search(ctx context.Context, search domain.UserSearch) ([]domain.User, error)
+	SoftDelete(ctx context.Context, id ksuid.KSUID) error
+	Restore(ctx context.Context, id ksuid.KSUID, gracePeriod time.Duration) (bool, error)
+	Purge(ctx context.Context, gracePeriod time.Duration) (int64, error)
+	Delete(ctx context.Context, id ksuid.KSUID) error
 }
 
 // User repository structure.
@@ -54,10 +72,14 @@ func NewUserRepository(psql postgres.Postgres) *UserRepository {
 // Creating a new user in postgres database.
 func (r *UserRepository) Create(ctx context.Context, user domain.User) error {
 	// Query to create user.
-	query := fmt.Sprintf(`INSERT INTO "%s" (id, username, email, password) VALUES ($1, $2, $3, $4)`, UserTable)
+	query := fmt.Sprintf(`INSERT INTO "%s" (id, username, email, password, verified)
+		SELECT $1, $2::VARCHAR, $3, $4, $5::BOOLEAN WHERE NOT EXISTS (
+			SELECT 1 FROM "%s" WHERE username=$2 AND reserved_until > now()
+		)`, UserTable, UsernameHistoryTable)
 
 	// Query to create a new user.
-	if _, err := r.psql.Exec(ctx, query, user.Id, user.Username, user.Email, user.Password); err != nil {
+	tag, err := r.psql.Exec(ctx, query, user.Id, user.Username, user.Email, user.Password, user.Verified)
+	if err != nil {
 		var pgErr *pgconn.PgError
 
 		// Get postgres error.
@@ -73,6 +95,11 @@ func (r *UserRepository) Create(ctx context.Context, user domain.User) error {
 		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
 	}
 
+	// Check if username is reserved for its previous owner.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeAlreadyExists, Message: "User already exists"}
+	}
+
 	return nil
 }
 
@@ -81,13 +108,14 @@ func (r *UserRepository) GetByID(ctx context.Context, id ksuid.KSUID) (domain.Us
 	var user domain.User
 
 	// Query for get user by id.
-	query := fmt.Sprintf(`SELECT "username", "last_visit", "verified", "avatar_url"
-		FROM "%s" WHERE "id"=$1`, UserTable)
+	query := fmt.Sprintf(`SELECT "username", "email", "last_visit", "verified", "avatar_url",
+		"deleted_at" FROM "%s" WHERE "id"=$1`, UserTable)
 
 	row := r.psql.QueryRow(ctx, query, id)
 
 	// Scanning query row.
-	err := row.Scan(&user.Username, &user.LastVisit, &user.Verified, &user.AvatarUrl)
+	err := row.Scan(&user.Username, &user.Email, &user.LastVisit, &user.Verified, &user.AvatarUrl,
+		&user.DeletedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
@@ -99,19 +127,56 @@ func (r *UserRepository) GetByID(ctx context.Context, id ksuid.KSUID) (domain.Us
 	return user, nil
 }
 
+// Get users by ids in postgres database, users that are not found are skipped.
+func (r *UserRepository) GetByIDs(ctx context.Context, ids []ksuid.KSUID) ([]domain.User, error) {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+
+	// Query for get users by ids.
+	query := fmt.Sprintf(`SELECT "id", "username", "last_visit", "verified", "avatar_url"
+		FROM "%s" WHERE "id" = ANY($1::CHAR(27)[]) AND "deleted_at" IS NULL`, UserTable)
+	rows, err := r.psql.Query(ctx, query, values)
+	if err != nil {
+		return nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, len(ids))
+
+	// Scanning query rows.
+	for rows.Next() {
+		var user domain.User
+
+		if err := rows.Scan(&user.Id, &user.Username, &user.LastVisit, &user.Verified,
+			&user.AvatarUrl); err != nil {
+			return nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return users, nil
+}
+
 // Get user by username in postgres database.
 func (r *UserRepository) GetByUsername(ctx context.Context, username string) (domain.User, error) {
 	var user domain.User
 
 	// Query for get user by username.
 	query := fmt.Sprintf(`SELECT "id", "email", "password", "last_visit", "verified",
-		"avatar_url" FROM "%s" WHERE username=$1`, UserTable)
+		"avatar_url", "deleted_at" FROM "%s" WHERE username=$1`, UserTable)
 
 	row := r.psql.QueryRow(ctx, query, username)
 
 	// Scanning query row.
 	err := row.Scan(&user.Id, &user.Email, &user.Password, &user.LastVisit,
-		&user.Verified, &user.AvatarUrl)
+		&user.Verified, &user.AvatarUrl, &user.DeletedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
@@ -124,19 +189,362 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (do
 }
 
 // Forgot password in postgres database.
-func (r *UserRepository) ForgotPassword(ctx context.Context, password, email string) error {
+func (r *UserRepository) ForgotPassword(ctx context.Context, password, email string) (ksuid.KSUID, error) {
+	var id ksuid.KSUID
+
 	// Query to update user password.
-	query := fmt.Sprintf(`UPDATE "%s" SET password=$1 WHERE email=$2`, UserTable)
-	_, err := r.psql.Exec(ctx, query, password, email)
+	query := fmt.Sprintf(`UPDATE "%s" SET password=$1 WHERE email=$2 RETURNING id`, UserTable)
+	row := r.psql.QueryRow(ctx, query, password, email)
 
-	return err
+	if err := row.Scan(&id); err != nil {
+		// Check if user is not found.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ksuid.Nil, nil
+		}
+
+		return ksuid.Nil, err
+	}
+
+	return id, nil
 }
 
-// Update user avatar in postgres database.
-func (r *UserRepository) UpdateAvatar(ctx context.Context, avatarUrl string, id ksuid.KSUID) error {
+// Update user avatar in postgres database, nil avatar url clears the avatar.
+func (r *UserRepository) UpdateAvatar(ctx context.Context, avatarUrl *string, id ksuid.KSUID) error {
 	// Query to update user avatar.
 	query := fmt.Sprintf(`UPDATE "%s" SET "avatar_url"=$1 WHERE "id"=$2`, UserTable)
-	_, err := r.psql.Exec(ctx, query, avatarUrl, id)
+
+	tag, err := r.psql.Exec(ctx, query, avatarUrl, id)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Check if user is not found.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return nil
+}
+
+// Update user last visit in postgres database, last visit is never moved back.
+func (r *UserRepository) UpdateLastVisit(ctx context.Context, id ksuid.KSUID) error {
+	// Query to update user last visit by the database clock.
+	query := fmt.Sprintf(`UPDATE "%s" SET "last_visit"=now() WHERE "id"=$1 AND "last_visit" < now()`, UserTable)
+	if _, err := r.psql.Exec(ctx, query, id); err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return nil
+}
+
+// Setting user verified status in postgres database.
+func (r *UserRepository) SetVerified(ctx context.Context, id ksuid.KSUID, verified bool) error {
+	// Query to update user verified status.
+	query := fmt.Sprintf(`UPDATE "%s" SET "verified"=$1 WHERE "id"=$2`, UserTable)
+
+	tag, err := r.psql.Exec(ctx, query, verified, id)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Check if user is not found.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return nil
+}
+
+// Update user email address in postgres database.
+func (r *UserRepository) UpdateEmail(ctx context.Context, id ksuid.KSUID, email string) error {
+	// Query to update user email address.
+	query := fmt.Sprintf(`UPDATE "%s" SET "email"=$1 WHERE "id"=$2`, UserTable)
+
+	tag, err := r.psql.Exec(ctx, query, email, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		// Return error if user with same email exists.
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return &domain.Error{Code: domain.CodeAlreadyExists, Message: "Email already in use"}
+		}
+
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Check if user is not found.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return nil
+}
+
+// Get user password hash in postgres database.
+func (r *UserRepository) GetPassword(ctx context.Context, id ksuid.KSUID) (string, error) {
+	var password string
+
+	// Query for get user password hash.
+	query := fmt.Sprintf(`SELECT "password" FROM "%s" WHERE "id"=$1`, UserTable)
+
+	if err := r.psql.QueryRow(ctx, query, id).Scan(&password); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+		}
+
+		return "", &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return password, nil
+}
+
+// Update user password in postgres database.
+func (r *UserRepository) UpdatePassword(ctx context.Context, id ksuid.KSUID, password string) error {
+	// Query to update user password.
+	query := fmt.Sprintf(`UPDATE "%s" SET "password"=$1 WHERE "id"=$2`, UserTable)
+
+	tag, err := r.psql.Exec(ctx, query, password, id)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Check if user is not found.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return nil
+}
+
+// Update username and keep the previous one in history in postgres database.
+func (r *UserRepository) UpdateUsername(ctx context.Context, id ksuid.KSUID, username string, cooldown, reservation time.Duration) error {
+	var (
+		previous string
+		changed  bool
+		reserved bool
+	)
+
+	// Starting a new transaction.
+	tx, err := r.psql.Begin(ctx)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+	// Rollback the transaction if it has not been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Query for get and lock current username.
+	query := fmt.Sprintf(`SELECT "username" FROM "%s" WHERE "id"=$1 FOR UPDATE`, UserTable)
+	if err := tx.QueryRow(ctx, query, id).Scan(&previous); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+		}
+
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	} else if previous == username {
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Username is not changed"}
+	}
+
+	// Query for check if username was changed within the cooldown, computed by the database clock.
+	query = fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM "%s" WHERE "user_id"=$1
+		AND "released_at" > now() - $2::interval)`, UsernameHistoryTable)
+	if err := tx.QueryRow(ctx, query, id, cooldown).Scan(&changed); err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	} else if changed {
+		return &domain.Error{Code: domain.CodeFailedPrecondition, Message: "Username changed too recently"}
+	}
+
+	// Query for check if username is reserved for another user.
+	query = fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM "%s" WHERE "username"=$1 AND "user_id"<>$2
+		AND "reserved_until" > now())`, UsernameHistoryTable)
+	if err := tx.QueryRow(ctx, query, username, id).Scan(&reserved); err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	} else if reserved {
+		return &domain.Error{Code: domain.CodeAlreadyExists, Message: "Username already exists"}
+	}
+
+	// Query to update username.
+	query = fmt.Sprintf(`UPDATE "%s" SET "username"=$1 WHERE "id"=$2`, UserTable)
+	if _, err := tx.Exec(ctx, query, username, id); err != nil {
+		var pgErr *pgconn.PgError
+
+		// Return error if user with same username exists.
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return &domain.Error{Code: domain.CodeAlreadyExists, Message: "Username already exists"}
+		}
+
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Query to keep previous username in history, reserved until computed by the database clock.
+	query = fmt.Sprintf(`INSERT INTO "%s" (user_id, username, reserved_until) VALUES ($1, $2, now() + $3::interval)`,
+		UsernameHistoryTable)
+	if _, err := tx.Exec(ctx, query, id, previous, reservation); err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Committing the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return nil
+}
+
+// Resolve previous username to the id of its last owner in postgres database.
+func (r *UserRepository) ResolveUsername(ctx context.Context, username string) (ksuid.KSUID, error) {
+	var id ksuid.KSUID
+
+	// Query for get the last owner of previous username.
+	query := fmt.Sprintf(`SELECT "user_id" FROM "%s" WHERE "username"=$1
+		ORDER BY "released_at" DESC LIMIT 1`, UsernameHistoryTable)
+
+	if err := r.psql.QueryRow(ctx, query, username).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ksuid.Nil, &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+		}
+
+		return ksuid.Nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return id, nil
+}
+
+// Escaping LIKE pattern special characters.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Searching users by username prefix and trigram similarity, newest users first.
+func (r *UserRepository) Search(ctx context.Context, search domain.UserSearch) ([]domain.User, error) {
+	// Deleted users are never matched.
+	conditions := []string{`"deleted_at" IS NULL`}
+	args := make([]interface{}, 0, 5)
+
+	// Matching username prefix or similar username.
+	if search.Query != "" {
+		query := strings.ToLower(search.Query)
+
+		args = append(args, likeEscaper.Replace(query)+"%", query)
+		conditions = append(conditions, fmt.Sprintf(`(lower("username") LIKE $%d OR lower("username") %% $%d)`,
+			len(args)-1, len(args)))
+	}
+
+	// Matching verified status.
+	if search.Verified != nil {
+		args = append(args, *search.Verified)
+		conditions = append(conditions, fmt.Sprintf(`"verified"=$%d`, len(args)))
+	}
+
+	// Matching users before the cursor, ksuid strings are sorted by creation time.
+	if search.Before != ksuid.Nil {
+		args = append(args, search.Before.String())
+		conditions = append(conditions, fmt.Sprintf(`"id" COLLATE "C" < $%d`, len(args)))
+	}
+
+	args = append(args, search.Limit)
+
+	// Query for search users.
+	query := fmt.Sprintf(`SELECT "id", "username", "last_visit", "verified", "avatar_url" FROM "%s"
+		WHERE %s ORDER BY "id" COLLATE "C" DESC LIMIT $%d`, UserTable, strings.Join(conditions, " AND "),
+		len(args))
+	rows, err := r.psql.Query(ctx, query, args...)
+	if err != nil {
+		return nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, search.Limit)
+
+	// Scanning query rows.
+	for rows.Next() {
+		var user domain.User
+
+		if err := rows.Scan(&user.Id, &user.Username, &user.LastVisit, &user.Verified,
+			&user.AvatarUrl); err != nil {
+			return nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return users, nil
+}
+
+// Soft delete user in postgres database.
+func (r *UserRepository) SoftDelete(ctx context.Context, id ksuid.KSUID) error {
+	// Query to mark user as deleted.
+	query := fmt.Sprintf(`UPDATE "%s" SET "deleted_at"=now() WHERE "id"=$1 AND "deleted_at" IS NULL`, UserTable)
+
+	tag, err := r.psql.Exec(ctx, query, id)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Check if user is not found or already deleted.
+	if tag.RowsAffected() == 0 {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return nil
+}
+
+// Restore user soft deleted during the grace period in postgres database. Returns false if the
+// user is deleted and the grace period is over.
+func (r *UserRepository) Restore(ctx context.Context, id ksuid.KSUID, gracePeriod time.Duration) (bool, error) {
+	var active bool
+
+	// Query to unmark user as deleted during the grace period. The outer select sees the rows before
+	// the update, so the user is active if restored or if it exists and is not deleted.
+	query := fmt.Sprintf(`WITH "restored" AS (UPDATE "%s" SET "deleted_at"=NULL WHERE "id"=$1
+		AND "deleted_at" IS NOT NULL AND "deleted_at" > now() - $2::interval RETURNING "id")
+		SELECT EXISTS (SELECT 1 FROM "restored") OR EXISTS (SELECT 1 FROM "%s" WHERE "id"=$1
+		AND "deleted_at" IS NULL)`, UserTable, UserTable)
+	if err := r.psql.QueryRow(ctx, query, id, gracePeriod).Scan(&active); err != nil {
+		return false, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return active, nil
+}
+
+// Purge users deleted before the grace period and their sessions in postgres database.
+func (r *UserRepository) Purge(ctx context.Context, gracePeriod time.Duration) (int64, error) {
+	// Starting a new transaction.
+	tx, err := r.psql.Begin(ctx)
+	if err != nil {
+		return 0, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+	// Rollback the transaction if it has not been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Query to delete sessions of the purged users, sessions are not deleted by cascade.
+	query := fmt.Sprintf(`DELETE FROM "%s" WHERE "user_id" IN (SELECT "id" FROM "%s"
+		WHERE "deleted_at" < now() - $1::interval)`, SessionTable, UserTable)
+	if _, err := tx.Exec(ctx, query, gracePeriod); err != nil {
+		return 0, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Query to delete users, releasing their usernames and email addresses.
+	query = fmt.Sprintf(`DELETE FROM "%s" WHERE "deleted_at" < now() - $1::interval`, UserTable)
+	tag, err := tx.Exec(ctx, query, gracePeriod)
+	if err != nil {
+		return 0, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	// Committing the transaction.
+	if err := tx.Commit(ctx); err != nil {
+		return 0, &domain.Error{Code: domain.CodeInternal, Message: "Internal Server Error"}
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Deleting user in postgres database.
+func (r *UserRepository) Delete(ctx context.Context, id ksuid.KSUID) error {
+	// Query to delete user.
+	query := fmt.Sprintf(`DELETE FROM "%s" WHERE "id"=$1`, UserTable)
+	_, err := r.psql.Exec(ctx, query, id)
 
 	return err
 }
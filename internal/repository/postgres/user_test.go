@@ -27,6 +27,9 @@ import (
 	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/internal/repository/postgres"
 
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
 	"github.com/pashagolub/pgxmock"
 	"github.com/segmentio/ksuid"
 )
@@ -63,13 +66,31 @@ func TestUserRepository_Create(t *testing.T) {
 				Username: "example",
 				Email:    "example@durudex.com",
 				Password: "qwerty",
+				Verified: true,
 			}},
 			mockBehavior: func(args args) {
 				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.UserTable)).
-					WithArgs(args.user.Id, args.user.Username, args.user.Email, args.user.Password).
+					WithArgs(args.user.Id, args.user.Username, args.user.Email, args.user.Password,
+						args.user.Verified).
 					WillReturnResult(pgxmock.NewResult("", 1))
 			},
 		},
+		{
+			name: "Reserved Username",
+			args: args{user: domain.User{
+				Id:       ksuid.New(),
+				Username: "example",
+				Email:    "example@durudex.com",
+				Password: "qwerty",
+			}},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.UserTable)).
+					WithArgs(args.user.Id, args.user.Username, args.user.Email, args.user.Password,
+						args.user.Verified).
+					WillReturnResult(pgxmock.NewResult("INSERT", 0))
+			},
+		},
 	}
 
 	// Conducting tests in various structures.
@@ -80,7 +101,7 @@ func TestUserRepository_Create(t *testing.T) {
 			// Creating a new user in postgres database.
 			err := repos.Create(context.Background(), tt.args.user)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("error creating user: %s", err.Error())
+				t.Errorf("error creating user: %v", err)
 			}
 		})
 	}
@@ -117,14 +138,16 @@ func TestUserRepository_GetByID(t *testing.T) {
 			args: args{id: ksuid.New()},
 			want: domain.User{
 				Username:  "example",
+				Email:     "example@durudex.com",
 				LastVisit: time.Now(),
 				Verified:  true,
 				AvatarUrl: nil,
 			},
 			mockBehavior: func(args args, user domain.User) {
 				rows := mock.NewRows([]string{
-					"username", "last_visit", "verified", "avatar_url",
-				}).AddRow(user.Username, user.LastVisit, user.Verified, user.AvatarUrl)
+					"username", "email", "last_visit", "verified", "avatar_url", "deleted_at",
+				}).AddRow(user.Username, user.Email, user.LastVisit, user.Verified, user.AvatarUrl,
+					user.DeletedAt)
 
 				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.UserTable)).
 					WithArgs(args.id).
@@ -152,6 +175,87 @@ func TestUserRepository_GetByID(t *testing.T) {
 	}
 }
 
+// Testing getting users by ids in postgres database.
+func TestUserRepository_GetByIDs(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ ids []ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, users []domain.User)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	found, missing := ksuid.New(), ksuid.New()
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         []domain.User
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{ids: []ksuid.KSUID{missing, found}},
+			want: []domain.User{{
+				Id:        found,
+				Username:  "example",
+				LastVisit: time.Now(),
+				Verified:  true,
+				AvatarUrl: nil,
+			}},
+			mockBehavior: func(args args, users []domain.User) {
+				rows := mock.NewRows([]string{"id", "username", "last_visit", "verified", "avatar_url"})
+				for _, user := range users {
+					rows.AddRow(user.Id, user.Username, user.LastVisit, user.Verified, user.AvatarUrl)
+				}
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s" WHERE "id" = ANY`, postgres.UserTable)).
+					WithArgs([]string{missing.String(), found.String()}).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:    "Internal Error",
+			args:    args{ids: []ksuid.KSUID{found}},
+			want:    nil,
+			wantErr: true,
+			mockBehavior: func(args args, users []domain.User) {
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s" WHERE "id" = ANY`, postgres.UserTable)).
+					WithArgs([]string{found.String()}).
+					WillReturnError(pgx.ErrTxClosed)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting users by ids.
+			got, err := repos.GetByIDs(context.Background(), tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting users by ids: %v", err)
+			}
+
+			// Check for similarity of users.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error users are not similar")
+			}
+		})
+	}
+}
+
 // Testing getting user by username in postgres database.
 func TestUserRepository_GetByUsername(t *testing.T) {
 	// Creating a new mock connection.
@@ -191,9 +295,9 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 			},
 			mockBehavior: func(args args, user domain.User) {
 				rows := mock.NewRows([]string{
-					"id", "email", "password", "last_visit", "verified", "avatar_url",
+					"id", "email", "password", "last_visit", "verified", "avatar_url", "deleted_at",
 				}).AddRow(user.Id.String(), user.Email, user.Password, user.LastVisit,
-					user.Verified, user.AvatarUrl)
+					user.Verified, user.AvatarUrl, user.DeletedAt)
 
 				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.UserTable)).
 					WithArgs(args.username).
@@ -234,7 +338,7 @@ func TestUserRepository_ForgotPassword(t *testing.T) {
 	type args struct{ email, password string }
 
 	// Test behavior.
-	type mockBehavior func(args args)
+	type mockBehavior func(args args, want ksuid.KSUID)
 
 	// Creating a new repository.
 	repos := postgres.NewUserRepository(mock)
@@ -243,16 +347,20 @@ func TestUserRepository_ForgotPassword(t *testing.T) {
 	tests := []struct {
 		name         string
 		args         args
+		want         ksuid.KSUID
 		wantErr      bool
 		mockBehavior mockBehavior
 	}{
 		{
 			name: "OK",
 			args: args{email: "example@example.example", password: "qwerty"},
-			mockBehavior: func(args args) {
-				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+			want: ksuid.New(),
+			mockBehavior: func(args args, want ksuid.KSUID) {
+				rows := mock.NewRows([]string{"id"}).AddRow(want)
+
+				mock.ExpectQuery(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
 					WithArgs(args.password, args.email).
-					WillReturnResult(pgxmock.NewResult("", 1))
+					WillReturnRows(rows)
 			},
 		},
 	}
@@ -260,13 +368,18 @@ func TestUserRepository_ForgotPassword(t *testing.T) {
 	// Conducting tests in various structures.
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.mockBehavior(tt.args)
+			tt.mockBehavior(tt.args, tt.want)
 
 			// Forgot password in postgres database.
-			err := repos.ForgotPassword(context.Background(), tt.args.password, tt.args.email)
+			got, err := repos.ForgotPassword(context.Background(), tt.args.password, tt.args.email)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("error forgot user password: %s", err.Error())
 			}
+
+			// Check for similarity of user id.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error user id are not similar")
+			}
 		})
 	}
 }
@@ -282,7 +395,7 @@ func TestUserRepository_UpdateAvatar(t *testing.T) {
 
 	// Testing args.
 	type args struct {
-		avatarUrl string
+		avatarUrl *string
 		id        ksuid.KSUID
 	}
 
@@ -292,6 +405,8 @@ func TestUserRepository_UpdateAvatar(t *testing.T) {
 	// Creating a new repository.
 	repos := postgres.NewUserRepository(mock)
 
+	avatarUrl := "https://cdn.durudex.com/avatar/0ujzPyRiIAffKhBux4PvQdDqMHY/user.png"
+
 	// Tests structures.
 	tests := []struct {
 		name         string
@@ -302,7 +417,19 @@ func TestUserRepository_UpdateAvatar(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				avatarUrl: "https://cdn.durudex.com/avatar/0ujzPyRiIAffKhBux4PvQdDqMHY/user.png",
+				avatarUrl: &avatarUrl,
+				id:        ksuid.New(),
+			},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.avatarUrl, args.id).
+					WillReturnResult(pgxmock.NewResult("", 1))
+			},
+		},
+		{
+			name: "Clear",
+			args: args{
+				avatarUrl: nil,
 				id:        ksuid.New(),
 			},
 			mockBehavior: func(args args) {
@@ -311,6 +438,19 @@ func TestUserRepository_UpdateAvatar(t *testing.T) {
 					WillReturnResult(pgxmock.NewResult("", 1))
 			},
 		},
+		{
+			name: "Not Found",
+			args: args{
+				avatarUrl: &avatarUrl,
+				id:        ksuid.New(),
+			},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.avatarUrl, args.id).
+					WillReturnResult(pgxmock.NewResult("", 0))
+			},
+		},
 	}
 
 	// Conducting tests in various structures.
@@ -321,7 +461,915 @@ func TestUserRepository_UpdateAvatar(t *testing.T) {
 			// Update user avatar in postgres database.
 			err := repos.UpdateAvatar(context.Background(), tt.args.avatarUrl, tt.args.id)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("error updating user avatar: %s", err.Error())
+				t.Errorf("error updating user avatar: %v", err)
+			}
+		})
+	}
+}
+
+// Testing updating user last visit in postgres database.
+func TestUserRepository_UpdateLastVisit(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ id ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New()},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s" SET "last_visit"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("", 1))
+			},
+		},
+		{
+			name: "Not Moved Back",
+			args: args{id: ksuid.New()},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s" SET "last_visit"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("", 0))
+			},
+		},
+		{
+			name:    "Internal Error",
+			args:    args{id: ksuid.New()},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s" SET "last_visit"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnError(pgx.ErrTxClosed)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Updating user last visit.
+			err := repos.UpdateLastVisit(context.Background(), tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error updating user last visit: %v", err)
+			}
+		})
+	}
+}
+
+// Testing setting user verified status in postgres database.
+func TestUserRepository_SetVerified(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id       ksuid.KSUID
+		verified bool
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New(), verified: true},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.verified, args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{id: ksuid.New(), verified: true},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.verified, args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Setting user verified status in postgres database.
+			err := repos.SetVerified(context.Background(), tt.args.id, tt.args.verified)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error setting user verified status: %v", err)
+			}
+		})
+	}
+}
+
+// Testing updating user email address in postgres database.
+func TestUserRepository_UpdateEmail(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id    ksuid.KSUID
+		email string
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New(), email: "example@durudex.com"},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.email, args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:    "Already Exists",
+			args:    args{id: ksuid.New(), email: "example@durudex.com"},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.email, args.id).
+					WillReturnError(&pgconn.PgError{Code: pgerrcode.UniqueViolation})
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{id: ksuid.New(), email: "example@durudex.com"},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.email, args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Updating user email address in postgres database.
+			err := repos.UpdateEmail(context.Background(), tt.args.id, tt.args.email)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error updating user email: %v", err)
+			}
+		})
+	}
+}
+
+// Testing getting user password hash in postgres database.
+func TestUserRepository_GetPassword(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ id ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args, want string)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         string
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New()},
+			want: "qwerty",
+			mockBehavior: func(args args, want string) {
+				rows := mock.NewRows([]string{"password"}).AddRow(want)
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{id: ksuid.New()},
+			wantErr: true,
+			mockBehavior: func(args args, want string) {
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Getting user password hash.
+			got, err := repos.GetPassword(context.Background(), tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting user password: %v", err)
+			}
+
+			// Check for similarity of password hash.
+			if got != tt.want {
+				t.Error("error password are not similar")
+			}
+		})
+	}
+}
+
+// Testing updating user password in postgres database.
+func TestUserRepository_UpdatePassword(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id       ksuid.KSUID
+		password string
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New(), password: "qwerty"},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.password, args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Updating user password in postgres database.
+			err := repos.UpdatePassword(context.Background(), tt.args.id, tt.args.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error updating user password: %v", err)
+			}
+		})
+	}
+}
+
+// Testing updating username in postgres database.
+func TestUserRepository_UpdateUsername(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id                    ksuid.KSUID
+		username              string
+		cooldown, reservation time.Duration
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Testing arguments of all tests.
+	arguments := args{
+		id:          ksuid.New(),
+		username:    "example",
+		cooldown:    time.Hour * 720,
+		reservation: time.Hour * 2160,
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: arguments,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(fmt.Sprintf(`SELECT "username" FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnRows(mock.NewRows([]string{"username"}).AddRow("previous"))
+				mock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS (.+) FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.id, args.cooldown).
+					WillReturnRows(mock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS (.+) FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.username, args.id).
+					WillReturnRows(mock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s"`, postgres.UserTable)).
+					WithArgs(args.username, args.id).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(fmt.Sprintf(`INSERT INTO "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.id, "previous", args.reservation).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Internal",
+			args:    arguments,
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(fmt.Sprintf(`SELECT "username" FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnError(pgx.ErrTxClosed)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "Cooldown",
+			args:    arguments,
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(fmt.Sprintf(`SELECT "username" FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnRows(mock.NewRows([]string{"username"}).AddRow("previous"))
+				mock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS (.+) FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.id, args.cooldown).
+					WillReturnRows(mock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "Reserved",
+			args:    arguments,
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(fmt.Sprintf(`SELECT "username" FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnRows(mock.NewRows([]string{"username"}).AddRow("previous"))
+				mock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS (.+) FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.id, args.cooldown).
+					WillReturnRows(mock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS (.+) FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.username, args.id).
+					WillReturnRows(mock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Updating username in postgres database.
+			err := repos.UpdateUsername(context.Background(), tt.args.id, tt.args.username,
+				tt.args.cooldown, tt.args.reservation)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error updating username: %v", err)
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error expectations were not met: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing resolving previous username in postgres database.
+func TestUserRepository_ResolveUsername(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ username string }
+
+	// Test behavior.
+	type mockBehavior func(args args, want ksuid.KSUID)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         ksuid.KSUID
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{username: "example"},
+			want: ksuid.New(),
+			mockBehavior: func(args args, want ksuid.KSUID) {
+				rows := mock.NewRows([]string{"user_id"}).AddRow(want)
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT "user_id" FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.username).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{username: "example"},
+			want:    ksuid.Nil,
+			wantErr: true,
+			mockBehavior: func(args args, want ksuid.KSUID) {
+				mock.ExpectQuery(fmt.Sprintf(`SELECT "user_id" FROM "%s"`, postgres.UsernameHistoryTable)).
+					WithArgs(args.username).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Resolving previous username.
+			got, err := repos.ResolveUsername(context.Background(), tt.args.username)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error resolving username: %v", err)
+			}
+
+			// Check for similarity of user id.
+			if got != tt.want {
+				t.Error("error user id are not similar")
+			}
+		})
+	}
+}
+
+// Testing searching users in postgres database.
+func TestUserRepository_Search(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ search domain.UserSearch }
+
+	// Test behavior.
+	type mockBehavior func(args args, users []domain.User)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	verified := true
+	before := ksuid.New()
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         []domain.User
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{search: domain.UserSearch{
+				Query:    "Dur_",
+				Verified: &verified,
+				Before:   before,
+				Limit:    2,
+			}},
+			want: []domain.User{{
+				Id:        ksuid.New(),
+				Username:  "durudex",
+				LastVisit: time.Now(),
+				Verified:  true,
+			}},
+			mockBehavior: func(args args, users []domain.User) {
+				rows := mock.NewRows([]string{"id", "username", "last_visit", "verified", "avatar_url"})
+				for _, user := range users {
+					rows.AddRow(user.Id, user.Username, user.LastVisit, user.Verified, user.AvatarUrl)
+				}
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s" WHERE "deleted_at" IS NULL AND (.+) LIKE \$1 (.+) %% \$2\) AND "verified"=\$3 AND "id" COLLATE "C" < \$4 ORDER BY (.+) LIMIT \$5`,
+					postgres.UserTable)).
+					WithArgs(`dur\_%`, "dur_", true, before.String(), 2).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "All Users",
+			args: args{search: domain.UserSearch{Limit: 20}},
+			want: []domain.User{},
+			mockBehavior: func(args args, users []domain.User) {
+				rows := mock.NewRows([]string{"id", "username", "last_visit", "verified", "avatar_url"})
+
+				mock.ExpectQuery(fmt.Sprintf(`SELECT (.+) FROM "%s" WHERE "deleted_at" IS NULL ORDER BY (.+) LIMIT \$1`, postgres.UserTable)).
+					WithArgs(20).
+					WillReturnRows(rows)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args, tt.want)
+
+			// Searching users.
+			got, err := repos.Search(context.Background(), tt.args.search)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error searching users: %v", err)
+			}
+
+			// Check for similarity of users.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error users are not similar")
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error expectations were not met: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing soft deleting user in postgres database.
+func TestUserRepository_SoftDelete(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ id ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New()},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s" SET "deleted_at"=now\(\)`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("", 1))
+			},
+		},
+		{
+			name:    "Not Found",
+			args:    args{id: ksuid.New()},
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`UPDATE "%s" SET "deleted_at"=now\(\)`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("", 0))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Soft deleting user.
+			err := repos.SoftDelete(context.Background(), tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error soft deleting user: %v", err)
+			}
+		})
+	}
+}
+
+// Testing restoring soft deleted user in postgres database.
+func TestUserRepository_Restore(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct {
+		id          ksuid.KSUID
+		gracePeriod time.Duration
+	}
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         bool
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "Active",
+			args: args{id: ksuid.New(), gracePeriod: time.Hour},
+			want: true,
+			mockBehavior: func(args args) {
+				rows := mock.NewRows([]string{"active"}).AddRow(true)
+
+				mock.ExpectQuery(fmt.Sprintf(`WITH "restored" AS \(UPDATE "%s" SET "deleted_at"=NULL`,
+					postgres.UserTable)).
+					WithArgs(args.id, args.gracePeriod).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Not Found",
+			args: args{id: ksuid.New(), gracePeriod: time.Hour},
+			want: false,
+			mockBehavior: func(args args) {
+				rows := mock.NewRows([]string{"active"}).AddRow(false)
+
+				mock.ExpectQuery(fmt.Sprintf(`WITH "restored" AS \(UPDATE "%s" SET "deleted_at"=NULL`,
+					postgres.UserTable)).
+					WithArgs(args.id, args.gracePeriod).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Grace Period Over",
+			args: args{id: ksuid.New(), gracePeriod: time.Hour},
+			want: false,
+			mockBehavior: func(args args) {
+				rows := mock.NewRows([]string{"active"}).AddRow(false)
+
+				mock.ExpectQuery(fmt.Sprintf(`WITH "restored" AS \(UPDATE "%s" SET "deleted_at"=NULL`,
+					postgres.UserTable)).
+					WithArgs(args.id, args.gracePeriod).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:    "Internal Error",
+			args:    args{id: ksuid.New(), gracePeriod: time.Hour},
+			want:    false,
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectQuery(fmt.Sprintf(`WITH "restored" AS \(UPDATE "%s" SET "deleted_at"=NULL`,
+					postgres.UserTable)).
+					WithArgs(args.id, args.gracePeriod).
+					WillReturnError(pgx.ErrTxClosed)
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Restoring soft deleted user.
+			got, err := repos.Restore(context.Background(), tt.args.id, tt.args.gracePeriod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error restoring user: %v", err)
+			}
+
+			// Check if the user is active.
+			if got != tt.want {
+				t.Errorf("error user active: got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+// Testing purging deleted users in postgres database.
+func TestUserRepository_Purge(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ gracePeriod time.Duration }
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		want         int64
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{gracePeriod: time.Hour},
+			want: 2,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.SessionTable)).
+					WithArgs(args.gracePeriod).
+					WillReturnResult(pgxmock.NewResult("", 3))
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.gracePeriod).
+					WillReturnResult(pgxmock.NewResult("", 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Sessions Error",
+			args:    args{gracePeriod: time.Hour},
+			want:    0,
+			wantErr: true,
+			mockBehavior: func(args args) {
+				mock.ExpectBegin()
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.SessionTable)).
+					WithArgs(args.gracePeriod).
+					WillReturnError(pgx.ErrTxClosed)
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Purging deleted users.
+			got, err := repos.Purge(context.Background(), tt.args.gracePeriod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error purging users: %v", err)
+			}
+
+			// Check number of purged users.
+			if got != tt.want {
+				t.Errorf("error purged users: got %d, want %d", got, tt.want)
+			}
+
+			// Check that all expectations were met.
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("error expectations were not met: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Testing deleting user in postgres database.
+func TestUserRepository_Delete(t *testing.T) {
+	// Creating a new mock connection.
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("error creating a new mock connection: %s", err.Error())
+	}
+	defer mock.Close(context.Background())
+
+	// Testing args.
+	type args struct{ id ksuid.KSUID }
+
+	// Test behavior.
+	type mockBehavior func(args args)
+
+	// Creating a new repository.
+	repos := postgres.NewUserRepository(mock)
+
+	// Tests structures.
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		mockBehavior mockBehavior
+	}{
+		{
+			name: "OK",
+			args: args{id: ksuid.New()},
+			mockBehavior: func(args args) {
+				mock.ExpectExec(fmt.Sprintf(`DELETE FROM "%s"`, postgres.UserTable)).
+					WithArgs(args.id).
+					WillReturnResult(pgxmock.NewResult("", 1))
+			},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior(tt.args)
+
+			// Deleting user in postgres database.
+			err := repos.Delete(context.Background(), tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error deleting user: %s", err.Error())
 			}
 		})
 	}
@@ -0,0 +1,131 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+)
+
+// Redis module name.
+const SignInAttemptModule string = "signinattempt"
+
+// Script counting a failed sign in attempt and blocking the next attempts, the block grows
+// exponentially from the base delay up to the maximum delay and turns into the lockout once the
+// threshold is crossed. A longer existing block is never shortened.
+var failAttemptScript = redis.NewScript(`
+local failures = redis.call("INCR", KEYS[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[1])
+local ttl = tonumber(ARGV[3])
+if failures >= tonumber(ARGV[2]) then
+	ttl = tonumber(ARGV[5])
+else
+	for i = 2, failures do
+		ttl = ttl * 2
+		if ttl >= tonumber(ARGV[4]) then
+			ttl = tonumber(ARGV[4])
+			break
+		end
+	end
+end
+if ttl > 0 and ttl > redis.call("PTTL", KEYS[2]) then
+	redis.call("SET", KEYS[2], 1, "PX", ttl)
+end
+return failures
+`)
+
+// Failed sign in attempt block policy.
+type AttemptPolicy struct {
+	Window    time.Duration
+	Threshold int64
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	Lockout   time.Duration
+}
+
+// Sign in attempt repository interface.
+type Attempt interface {
+	Get(ctx context.Context, subject string) (int64, time.Duration, error)
+	Fail(ctx context.Context, subject string, policy AttemptPolicy) (int64, error)
+	Reset(ctx context.Context, subject string) error
+}
+
+// Sign in attempt repository structure.
+type AttemptRepository struct{ redis redis.Redis }
+
+// Creating a new sign in attempt repository.
+func NewAttemptRepository(redis redis.Redis) *AttemptRepository {
+	return &AttemptRepository{redis: redis}
+}
+
+// Getting the number of failed sign in attempts and the remaining block time.
+func (r *AttemptRepository) Get(ctx context.Context, subject string) (int64, time.Duration, error) {
+	var (
+		count *redis.StringCmd
+		ttl   *redis.DurationCmd
+	)
+
+	// Getting counter and block in a single round trip.
+	if _, err := r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Get(ctx, countKey(subject))
+		ttl = pipe.PTTL(ctx, blockKey(subject))
+
+		return nil
+	}); err != nil && err != redis.Nil {
+		return 0, 0, err
+	}
+
+	failures, err := count.Int64()
+	if err != nil && err != redis.Nil {
+		return 0, 0, err
+	}
+
+	// Negative ttl means that the block key does not exist.
+	blocked := ttl.Val()
+	if blocked < 0 {
+		blocked = 0
+	}
+
+	return failures, blocked, nil
+}
+
+// Incrementing the number of failed sign in attempts within the window and blocking the next
+// attempts atomically, so concurrent failures cannot slip past the block.
+func (r *AttemptRepository) Fail(ctx context.Context, subject string, policy AttemptPolicy) (int64, error) {
+	return failAttemptScript.Run(ctx, r.redis, []string{countKey(subject), blockKey(subject)},
+		policy.Window.Milliseconds(), policy.Threshold, policy.BaseDelay.Milliseconds(),
+		policy.MaxDelay.Milliseconds(), policy.Lockout.Milliseconds()).Int64()
+}
+
+// Resetting failed sign in attempts.
+func (r *AttemptRepository) Reset(ctx context.Context, subject string) error {
+	return r.redis.Del(ctx, countKey(subject), blockKey(subject)).Err()
+}
+
+// Getting failed sign in attempts counter key.
+func countKey(subject string) string {
+	return fmt.Sprintf("%s:%s", SignInAttemptModule, subject)
+}
+
+// Getting sign in block key.
+func blockKey(subject string) string {
+	return fmt.Sprintf("%s:block:%s", SignInAttemptModule, subject)
+}
@@ -0,0 +1,198 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// Testing counting failed sign in attempts and blocking the next attempts.
+func TestAttemptRepository_Fail(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewAttemptRepository(client)
+
+	// Failed sign in attempt block policy.
+	policy := redis.AttemptPolicy{
+		Window:    time.Minute * 15,
+		Threshold: 4,
+		BaseDelay: time.Second,
+		MaxDelay:  time.Second * 3,
+		Lockout:   time.Minute * 10,
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name        string
+		policy      redis.AttemptPolicy
+		advance     time.Duration
+		want        int64
+		wantBlocked time.Duration
+	}{
+		{name: "First Failure", policy: policy, want: 1, wantBlocked: time.Second},
+		{name: "Doubled Delay", policy: policy, want: 2, wantBlocked: time.Second * 2},
+		{name: "Max Delay", policy: policy, want: 3, wantBlocked: time.Second * 3},
+		{name: "Lockout", policy: policy, want: 4, wantBlocked: time.Minute * 10},
+		{
+			name:        "Lockout Not Shortened",
+			policy:      redis.AttemptPolicy{Window: time.Minute * 15, Threshold: 100, BaseDelay: time.Second},
+			advance:     time.Minute,
+			want:        5,
+			wantBlocked: time.Minute * 9,
+		},
+		{name: "Window Expired", policy: policy, advance: time.Minute * 15, want: 1, wantBlocked: time.Second},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Counting failed sign in attempt.
+			got, err := repos.Fail(context.Background(), "user:example", tt.policy)
+			if err != nil {
+				t.Fatalf("error failing attempt: %s", err.Error())
+			}
+
+			// Check number of failures.
+			if got != tt.want {
+				t.Errorf("error attempt failures: got %d, want %d", got, tt.want)
+			}
+
+			// Getting failed sign in attempts and remaining block time.
+			failures, blocked, err := repos.Get(context.Background(), "user:example")
+			if err != nil {
+				t.Fatalf("error getting attempts: %s", err.Error())
+			}
+
+			// Check stored failures and block time.
+			if failures != tt.want || blocked != tt.wantBlocked {
+				t.Errorf("error attempt state: got %d, %s, want %d, %s", failures, blocked, tt.want, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+// Testing concurrent counting of failed sign in attempts.
+func TestAttemptRepository_FailConcurrent(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewAttemptRepository(client)
+
+	// Number of simultaneous failures.
+	const workers = 16
+
+	var wg sync.WaitGroup
+
+	// Counting failed sign in attempts simultaneously.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := repos.Fail(context.Background(), "user:example", redis.AttemptPolicy{
+				Window:    time.Minute * 15,
+				Threshold: 10,
+				BaseDelay: time.Second,
+				MaxDelay:  time.Minute,
+				Lockout:   time.Minute * 15,
+			}); err != nil {
+				t.Errorf("error failing attempt: %s", err.Error())
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Getting failed sign in attempts and remaining block time.
+	failures, blocked, err := repos.Get(context.Background(), "user:example")
+	if err != nil {
+		t.Fatalf("error getting attempts: %s", err.Error())
+	}
+
+	// Check that every failure was counted and the subject is locked.
+	if failures != workers || blocked != time.Minute*15 {
+		t.Errorf("error attempt state: got %d, %s, want %d, %s", failures, blocked, workers, time.Minute*15)
+	}
+}
+
+// Testing resetting failed sign in attempts.
+func TestAttemptRepository_Reset(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewAttemptRepository(client)
+
+	// Counting failed sign in attempt.
+	if _, err := repos.Fail(context.Background(), "user:example", redis.AttemptPolicy{
+		Window:    time.Minute * 15,
+		Threshold: 1,
+		Lockout:   time.Minute * 15,
+	}); err != nil {
+		t.Fatalf("error failing attempt: %s", err.Error())
+	}
+
+	// Resetting failed sign in attempts.
+	if err := repos.Reset(context.Background(), "user:example"); err != nil {
+		t.Fatalf("error resetting attempts: %s", err.Error())
+	}
+
+	// Getting failed sign in attempts and remaining block time.
+	failures, blocked, err := repos.Get(context.Background(), "user:example")
+	if err != nil {
+		t.Fatalf("error getting attempts: %s", err.Error())
+	}
+
+	// Check that attempts were reset.
+	if failures != 0 || blocked != 0 {
+		t.Errorf("error attempt state: got %d, %s, want 0, 0s", failures, blocked)
+	}
+}
@@ -20,18 +20,43 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/pkg/database/redis"
 )
 
 // Redis module name.
 const EmailCodeModule string = "emailcode"
 
+// Script deleting the code and its verification attempts only if the code matches.
+var consumeCodeScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1], KEYS[2])
+return 1
+`)
+
+// Script incrementing a counter and setting its expiration if it has none, so the counter cannot be
+// left without expiration.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
 // Code repository interface.
 type Code interface {
-	CreateByEmail(ctx context.Context, email string, code uint64, ttl time.Duration) error
-	GetByEmail(ctx context.Context, email string) (uint64, error)
+	CreateByEmail(ctx context.Context, email, purpose string, code uint64, ttl time.Duration) error
+	GetByEmail(ctx context.Context, email, purpose string) (uint64, error)
+	ConsumeByEmail(ctx context.Context, email, purpose string, code uint64) (bool, error)
+	DeleteByEmail(ctx context.Context, email, purpose string) error
+	IncrementSends(ctx context.Context, subject string, window time.Duration) (int64, error)
+	IncrementAttempts(ctx context.Context, email, purpose string, ttl time.Duration) (int64, error)
 }
 
 // Code repository structure.
@@ -43,15 +68,70 @@ func NewCodeRepository(redis redis.Redis) *CodeRepository {
 }
 
 // Creating a new user verification email code.
-func (r *CodeRepository) CreateByEmail(ctx context.Context, email string, code uint64, ttl time.Duration) error {
-	key := fmt.Sprintf("%s:%s", EmailCodeModule, email)
+func (r *CodeRepository) CreateByEmail(ctx context.Context, email, purpose string, code uint64, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s:%s", EmailCodeModule, purpose, email)
+
+	// Setting a new code and resetting verification attempts of the previous one.
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetEX(ctx, key, code, ttl)
+		pipe.Del(ctx, fmt.Sprintf("%s:attempt:%s:%s", EmailCodeModule, purpose, email))
 
-	return r.redis.SetEX(ctx, key, code, ttl).Err()
+		return nil
+	})
+
+	return err
 }
 
 // Getting a user verification email code.
-func (r *CodeRepository) GetByEmail(ctx context.Context, email string) (uint64, error) {
-	key := fmt.Sprintf("%s:%s", EmailCodeModule, email)
+func (r *CodeRepository) GetByEmail(ctx context.Context, email, purpose string) (uint64, error) {
+	key := fmt.Sprintf("%s:%s:%s", EmailCodeModule, purpose, email)
+
+	code, err := r.redis.Get(ctx, key).Uint64()
+	if err == redis.Nil {
+		return 0, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Code"}
+	}
+
+	return code, err
+}
+
+// Atomically comparing and deleting a user verification email code.
+func (r *CodeRepository) ConsumeByEmail(ctx context.Context, email, purpose string, code uint64) (bool, error) {
+	keys := []string{
+		fmt.Sprintf("%s:%s:%s", EmailCodeModule, purpose, email),
+		fmt.Sprintf("%s:attempt:%s:%s", EmailCodeModule, purpose, email),
+	}
+
+	// Deleting the code only if it has not already been consumed.
+	consumed, err := consumeCodeScript.Run(ctx, r.redis, keys, strconv.FormatUint(code, 10)).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return consumed == 1, nil
+}
+
+// Deleting a user verification email code and its verification attempts.
+func (r *CodeRepository) DeleteByEmail(ctx context.Context, email, purpose string) error {
+	key := fmt.Sprintf("%s:%s:%s", EmailCodeModule, purpose, email)
+
+	return r.redis.Del(ctx, key, fmt.Sprintf("%s:attempt:%s:%s", EmailCodeModule, purpose, email)).Err()
+}
+
+// Incrementing the number of codes sent to the subject within the window.
+func (r *CodeRepository) IncrementSends(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf("%s:send:%s", EmailCodeModule, subject)
+
+	return r.increment(ctx, key, window)
+}
+
+// Incrementing the number of verification attempts of the current code.
+func (r *CodeRepository) IncrementAttempts(ctx context.Context, email, purpose string, ttl time.Duration) (int64, error) {
+	key := fmt.Sprintf("%s:attempt:%s:%s", EmailCodeModule, purpose, email)
+
+	return r.increment(ctx, key, ttl)
+}
 
-	return r.redis.Get(ctx, key).Uint64()
+// Incrementing a counter that expires after the first increment atomically.
+func (r *CodeRepository) increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return incrementScript.Run(ctx, r.redis, []string{key}, ttl.Milliseconds()).Int64()
 }
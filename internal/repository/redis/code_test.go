@@ -0,0 +1,189 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// Testing consuming a user verification email code.
+func TestCodeRepository_ConsumeByEmail(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewCodeRepository(client)
+
+	// Tests structures.
+	tests := []struct {
+		name  string
+		input uint64
+		want  bool
+	}{
+		{name: "Invalid", input: 654321, want: false},
+		{name: "OK", input: 123456, want: true},
+		{name: "Consumed", input: 123456, want: false},
+	}
+
+	// Creating a new code.
+	if err := repos.CreateByEmail(context.Background(), "example@durudex.com", "sign-up",
+		123456, time.Minute); err != nil {
+		t.Fatalf("error creating code: %s", err.Error())
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Consuming user verification email code.
+			got, err := repos.ConsumeByEmail(context.Background(), "example@durudex.com", "sign-up", tt.input)
+			if err != nil {
+				t.Fatalf("error consuming code: %s", err.Error())
+			}
+
+			// Check for similarity of result.
+			if got != tt.want {
+				t.Errorf("error consumed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Testing concurrent consuming of a user verification email code.
+func TestCodeRepository_ConsumeByEmailConcurrent(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewCodeRepository(client)
+
+	// Number of simultaneous verifications.
+	const workers = 16
+
+	// Creating a new code.
+	if err := repos.CreateByEmail(context.Background(), "example@durudex.com", "sign-up",
+		123456, time.Minute); err != nil {
+		t.Fatalf("error creating code: %s", err.Error())
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		consumed int
+	)
+
+	// Consuming the same code simultaneously.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ok, err := repos.ConsumeByEmail(context.Background(), "example@durudex.com", "sign-up", 123456)
+			if err != nil {
+				t.Errorf("error consuming code: %s", err.Error())
+				return
+			}
+
+			if ok {
+				mu.Lock()
+				consumed++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Check that the code was consumed only once.
+	if consumed != 1 {
+		t.Errorf("error code consumed %d times, want 1", consumed)
+	}
+}
+
+// Testing incrementing the number of codes sent to the subject.
+func TestCodeRepository_IncrementSends(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewCodeRepository(client)
+
+	// Setting a counter left without expiration.
+	if err := server.Set("emailcode:send:ip:127.0.0.2", "5"); err != nil {
+		t.Fatalf("error setting counter: %s", err.Error())
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		subject string
+		advance time.Duration
+		want    int64
+	}{
+		{name: "First Send", subject: "ip:127.0.0.1", want: 1},
+		{name: "Second Send", subject: "ip:127.0.0.1", advance: time.Minute * 30, want: 2},
+		{name: "Window Expired", subject: "ip:127.0.0.1", advance: time.Minute * 30, want: 1},
+		{name: "Without Expiration", subject: "ip:127.0.0.2", want: 6},
+		{name: "Expiration Set", subject: "ip:127.0.0.2", advance: time.Hour, want: 1},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Incrementing the number of codes sent.
+			got, err := repos.IncrementSends(context.Background(), tt.subject, time.Hour)
+			if err != nil {
+				t.Fatalf("error incrementing sends: %s", err.Error())
+			}
+
+			// Check for similarity of result.
+			if got != tt.want {
+				t.Errorf("error sends = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
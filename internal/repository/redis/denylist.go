@@ -0,0 +1,75 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Redis module name.
+const DenylistModule string = "denylist"
+
+// Access token denylist repository interface.
+type Denylist interface {
+	AddSessions(ctx context.Context, ids []ksuid.KSUID, ttl time.Duration) error
+	IsDenied(ctx context.Context, sessionId ksuid.KSUID) (bool, error)
+}
+
+// Access token denylist repository structure.
+type DenylistRepository struct{ redis redis.Redis }
+
+// Creating a new access token denylist repository.
+func NewDenylistRepository(redis redis.Redis) *DenylistRepository {
+	return &DenylistRepository{redis: redis}
+}
+
+// Adding user sessions to the access token denylist.
+func (r *DenylistRepository) AddSessions(ctx context.Context, ids []ksuid.KSUID, ttl time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Setting all keys in a single round trip.
+	_, err := r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			pipe.SetEX(ctx, fmt.Sprintf("%s:%s", DenylistModule, id), 1, ttl)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// Checking if a user session is in the access token denylist.
+func (r *DenylistRepository) IsDenied(ctx context.Context, sessionId ksuid.KSUID) (bool, error) {
+	key := fmt.Sprintf("%s:%s", DenylistModule, sessionId)
+
+	n, err := r.redis.Exists(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing adding user sessions to the access token denylist.
+func TestDenylistRepository_IsDenied(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewDenylistRepository(client)
+
+	ids := []ksuid.KSUID{ksuid.New(), ksuid.New()}
+
+	// Adding user sessions to the access token denylist.
+	if err := repos.AddSessions(context.Background(), ids, time.Minute*15); err != nil {
+		t.Fatalf("error adding sessions: %s", err.Error())
+	}
+
+	// Adding no user sessions to the access token denylist.
+	if err := repos.AddSessions(context.Background(), nil, time.Minute*15); err != nil {
+		t.Fatalf("error adding no sessions: %s", err.Error())
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name      string
+		sessionId ksuid.KSUID
+		advance   time.Duration
+		want      bool
+	}{
+		{name: "First Session", sessionId: ids[0], want: true},
+		{name: "Second Session", sessionId: ids[1], want: true},
+		{name: "Other Session", sessionId: ksuid.New(), want: false},
+		{name: "Expired", sessionId: ids[0], advance: time.Minute * 15, want: false},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Checking if a user session is in the access token denylist.
+			got, err := repos.IsDenied(context.Background(), tt.sessionId)
+			if err != nil {
+				t.Fatalf("error checking denylist: %s", err.Error())
+			}
+
+			// Check for similarity of result.
+			if got != tt.want {
+				t.Errorf("error denied = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Redis module name.
+const EmailRevertModule string = "emailrevert"
+
+// Email address change revert repository interface.
+type EmailRevert interface {
+	Create(ctx context.Context, token string, revert domain.EmailRevert, ttl time.Duration) error
+	Consume(ctx context.Context, token string) (domain.EmailRevert, error)
+}
+
+// Email address change revert repository structure.
+type EmailRevertRepository struct{ redis redis.Redis }
+
+// Creating a new email address change revert repository.
+func NewEmailRevertRepository(redis redis.Redis) *EmailRevertRepository {
+	return &EmailRevertRepository{redis: redis}
+}
+
+// Creating a new email address change revert.
+func (r *EmailRevertRepository) Create(ctx context.Context, token string, revert domain.EmailRevert, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", EmailRevertModule, token)
+
+	// Setting revert fields and expiration in a single round trip.
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "user_id", revert.UserId.String(), "email", revert.Email)
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	})
+
+	return err
+}
+
+// Consuming an email address change revert, so it can be used only once.
+func (r *EmailRevertRepository) Consume(ctx context.Context, token string) (domain.EmailRevert, error) {
+	key := fmt.Sprintf("%s:%s", EmailRevertModule, token)
+
+	var fields *redis.StringStringMapCmd
+
+	// Getting and deleting revert atomically.
+	if _, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		fields = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+
+		return nil
+	}); err != nil {
+		return domain.EmailRevert{}, err
+	} else if len(fields.Val()) == 0 {
+		return domain.EmailRevert{}, &domain.Error{Code: domain.CodeNotFound, Message: "Email revert not found"}
+	}
+
+	// Parsing revert user id.
+	userId, err := ksuid.Parse(fields.Val()["user_id"])
+	if err != nil {
+		return domain.EmailRevert{}, err
+	}
+
+	return domain.EmailRevert{UserId: userId, Email: fields.Val()["email"]}, nil
+}
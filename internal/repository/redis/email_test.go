@@ -0,0 +1,82 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing consuming a email address change revert.
+func TestEmailRevertRepository_Consume(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewEmailRevertRepository(client)
+
+	revert := domain.EmailRevert{UserId: ksuid.New(), Email: "example@durudex.com"}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		token   string
+		want    domain.EmailRevert
+		wantErr bool
+	}{
+		{name: "Unknown", token: "unknown", wantErr: true},
+		{name: "OK", token: "token", want: revert},
+		{name: "Consumed", token: "token", wantErr: true},
+	}
+
+	// Creating a new email address change revert.
+	if err := repos.Create(context.Background(), "token", revert, time.Minute); err != nil {
+		t.Fatalf("error creating email revert: %s", err.Error())
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Consuming a email address change revert.
+			got, err := repos.Consume(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error consuming email revert: %v", err)
+			}
+
+			// Check for similarity of email revert.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error email revert are not similar")
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Redis module name.
+const MagicLinkModule string = "magiclink"
+
+// Magic sign in link repository interface.
+type MagicLink interface {
+	Create(ctx context.Context, token string, link domain.MagicLink, ttl time.Duration) error
+	Consume(ctx context.Context, token string) (domain.MagicLink, error)
+	IncrementSends(ctx context.Context, subject string, window time.Duration) (int64, error)
+}
+
+// Magic sign in link repository structure.
+type MagicLinkRepository struct{ redis redis.Redis }
+
+// Creating a new magic sign in link repository.
+func NewMagicLinkRepository(redis redis.Redis) *MagicLinkRepository {
+	return &MagicLinkRepository{redis: redis}
+}
+
+// Creating a new magic sign in link.
+func (r *MagicLinkRepository) Create(ctx context.Context, token string, link domain.MagicLink, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", MagicLinkModule, token)
+
+	// Setting link fields and expiration in a single round trip.
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "user_id", link.UserId.String(), "ip", link.Ip)
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	})
+
+	return err
+}
+
+// Consuming a magic sign in link, so it can be used only once.
+func (r *MagicLinkRepository) Consume(ctx context.Context, token string) (domain.MagicLink, error) {
+	key := fmt.Sprintf("%s:%s", MagicLinkModule, token)
+
+	var fields *redis.StringStringMapCmd
+
+	// Getting and deleting link atomically.
+	if _, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		fields = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+
+		return nil
+	}); err != nil {
+		return domain.MagicLink{}, err
+	} else if len(fields.Val()) == 0 {
+		return domain.MagicLink{}, &domain.Error{Code: domain.CodeNotFound, Message: "Magic link not found"}
+	}
+
+	// Parsing link user id.
+	userId, err := ksuid.Parse(fields.Val()["user_id"])
+	if err != nil {
+		return domain.MagicLink{}, err
+	}
+
+	return domain.MagicLink{UserId: userId, Ip: fields.Val()["ip"]}, nil
+}
+
+// Incrementing the number of magic links requested for the subject within the window.
+func (r *MagicLinkRepository) IncrementSends(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf("%s:send:%s", MagicLinkModule, subject)
+
+	return incrementScript.Run(ctx, r.redis, []string{key}, window.Milliseconds()).Int64()
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing consuming a magic sign in link.
+func TestMagicLinkRepository_Consume(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewMagicLinkRepository(client)
+
+	link := domain.MagicLink{UserId: ksuid.New(), Ip: "127.0.0.1"}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		token   string
+		want    domain.MagicLink
+		wantErr bool
+	}{
+		{name: "Unknown", token: "unknown", wantErr: true},
+		{name: "OK", token: "token", want: link},
+		{name: "Consumed", token: "token", wantErr: true},
+	}
+
+	// Creating a new magic link.
+	if err := repos.Create(context.Background(), "token", link, time.Minute); err != nil {
+		t.Fatalf("error creating magic link: %s", err.Error())
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Consuming a magic sign in link.
+			got, err := repos.Consume(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error consuming magic link: %v", err)
+			}
+
+			// Check for similarity of magic link.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error("error magic link are not similar")
+			}
+		})
+	}
+}
+
+// Testing incrementing the number of magic links requested for the subject.
+func TestMagicLinkRepository_IncrementSends(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewMagicLinkRepository(client)
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		subject string
+		advance time.Duration
+		want    int64
+	}{
+		{name: "First Request", subject: "user:example", want: 1},
+		{name: "Second Request", subject: "user:example", advance: time.Minute * 30, want: 2},
+		{name: "Other Subject", subject: "ip:127.0.0.1", want: 1},
+		{name: "Window Expired", subject: "user:example", advance: time.Minute * 30, want: 1},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Incrementing the number of magic links requested.
+			got, err := repos.IncrementSends(context.Background(), tt.subject, time.Hour)
+			if err != nil {
+				t.Fatalf("error incrementing sends: %s", err.Error())
+			}
+
+			// Check for similarity of result.
+			if got != tt.want {
+				t.Errorf("error sends = %d, want %d", got, tt.want)
+			}
+
+			// Check that the counter is kept in the magic link module.
+			if !server.Exists(redis.MagicLinkModule + ":send:" + tt.subject) {
+				t.Errorf("error counter key does not exist: %s", tt.subject)
+			}
+		})
+	}
+}
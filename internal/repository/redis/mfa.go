@@ -0,0 +1,115 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Redis module name.
+const MFAChallengeModule string = "mfachallenge"
+
+// Script counting a failed challenge attempt and deleting the challenge after the maximum failures.
+var failChallengeScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+local failures = redis.call("HINCRBY", KEYS[1], "failures", 1)
+if failures >= tonumber(ARGV[1]) then
+	redis.call("DEL", KEYS[1])
+end
+return failures
+`)
+
+// Multi-factor authentication challenge repository interface.
+type MFA interface {
+	CreateChallenge(ctx context.Context, token string, challenge domain.MFAChallenge, ttl time.Duration) error
+	GetChallenge(ctx context.Context, token string) (domain.MFAChallenge, error)
+	DeleteChallenge(ctx context.Context, token string) (bool, error)
+	FailChallenge(ctx context.Context, token string, maxFailures int64) (int64, error)
+}
+
+// Multi-factor authentication challenge repository structure.
+type MFARepository struct{ redis redis.Redis }
+
+// Creating a new multi-factor authentication challenge repository.
+func NewMFARepository(redis redis.Redis) *MFARepository {
+	return &MFARepository{redis: redis}
+}
+
+// Creating a new multi-factor authentication challenge.
+func (r *MFARepository) CreateChallenge(ctx context.Context, token string, challenge domain.MFAChallenge, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", MFAChallengeModule, token)
+
+	// Setting challenge fields and expiration in a single round trip.
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "user_id", challenge.UserId.String(), "username", challenge.Username,
+			"email", challenge.Email)
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	})
+
+	return err
+}
+
+// Getting a multi-factor authentication challenge.
+func (r *MFARepository) GetChallenge(ctx context.Context, token string) (domain.MFAChallenge, error) {
+	key := fmt.Sprintf("%s:%s", MFAChallengeModule, token)
+
+	// Getting all challenge fields.
+	fields, err := r.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return domain.MFAChallenge{}, err
+	} else if len(fields) == 0 {
+		return domain.MFAChallenge{}, &domain.Error{Code: domain.CodeNotFound, Message: "Challenge not found"}
+	}
+
+	// Parsing challenge user id.
+	userId, err := ksuid.Parse(fields["user_id"])
+	if err != nil {
+		return domain.MFAChallenge{}, err
+	}
+
+	return domain.MFAChallenge{UserId: userId, Username: fields["username"], Email: fields["email"]}, nil
+}
+
+// Deleting a multi-factor authentication challenge.
+func (r *MFARepository) DeleteChallenge(ctx context.Context, token string) (bool, error) {
+	key := fmt.Sprintf("%s:%s", MFAChallengeModule, token)
+
+	// Deleting challenge and checking if it still existed.
+	deleted, err := r.redis.Del(ctx, key).Result()
+
+	return deleted != 0, err
+}
+
+// Counting a failed multi-factor authentication challenge attempt, the challenge is deleted after
+// the maximum failures. Returns the number of failures, zero if the challenge does not exist.
+func (r *MFARepository) FailChallenge(ctx context.Context, token string, maxFailures int64) (int64, error) {
+	key := fmt.Sprintf("%s:%s", MFAChallengeModule, token)
+
+	return failChallengeScript.Run(ctx, r.redis, []string{key}, maxFailures).Int64()
+}
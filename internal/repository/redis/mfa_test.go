@@ -0,0 +1,196 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing counting failed multi-factor authentication challenge attempts.
+func TestMFARepository_FailChallenge(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewMFARepository(client)
+
+	// Creating a new multi-factor authentication challenge.
+	if err := repos.CreateChallenge(context.Background(), "token", domain.MFAChallenge{
+		UserId: ksuid.New(),
+		Email:  "example@durudex.com",
+	}, time.Minute); err != nil {
+		t.Fatalf("error creating challenge: %s", err.Error())
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name       string
+		token      string
+		want       int64
+		wantExists bool
+	}{
+		{name: "Unknown", token: "unknown", want: 0, wantExists: true},
+		{name: "First Failure", token: "token", want: 1, wantExists: true},
+		{name: "Second Failure", token: "token", want: 2, wantExists: true},
+		{name: "Last Failure", token: "token", want: 3, wantExists: false},
+		{name: "Deleted", token: "token", want: 0, wantExists: false},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Counting failed challenge attempt.
+			got, err := repos.FailChallenge(context.Background(), tt.token, 3)
+			if err != nil {
+				t.Fatalf("error failing challenge: %s", err.Error())
+			}
+
+			// Check number of failures.
+			if got != tt.want {
+				t.Errorf("error challenge failures: got %d, want %d", got, tt.want)
+			}
+
+			// Check if the challenge still exists.
+			_, err = repos.GetChallenge(context.Background(), "token")
+			if (err == nil) != tt.wantExists {
+				t.Errorf("error challenge existence: %v", err)
+			}
+		})
+	}
+}
+
+// Testing getting a multi-factor authentication challenge.
+func TestMFARepository_GetChallenge(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewMFARepository(client)
+
+	challenge := domain.MFAChallenge{UserId: ksuid.New(), Username: "example", Email: "example@durudex.com"}
+
+	// Creating a new multi-factor authentication challenge.
+	if err := repos.CreateChallenge(context.Background(), "token", challenge, time.Minute); err != nil {
+		t.Fatalf("error creating challenge: %s", err.Error())
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		token   string
+		advance time.Duration
+		want    domain.MFAChallenge
+		wantErr bool
+	}{
+		{name: "OK", token: "token", want: challenge},
+		{name: "Not Consumed", token: "token", want: challenge},
+		{name: "Unknown", token: "unknown", wantErr: true},
+		{name: "Expired", token: "token", advance: time.Minute, wantErr: true},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Getting multi-factor authentication challenge.
+			got, err := repos.GetChallenge(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error getting challenge: %v", err)
+			}
+
+			// Check for similarity of a challenge.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("error challenge are not similar: %v", got)
+			}
+		})
+	}
+}
+
+// Testing deleting a multi-factor authentication challenge.
+func TestMFARepository_DeleteChallenge(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewMFARepository(client)
+
+	// Creating a new multi-factor authentication challenge.
+	if err := repos.CreateChallenge(context.Background(), "token", domain.MFAChallenge{
+		UserId: ksuid.New(),
+		Email:  "example@durudex.com",
+	}, time.Minute); err != nil {
+		t.Fatalf("error creating challenge: %s", err.Error())
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{name: "Unknown", token: "unknown", want: false},
+		{name: "OK", token: "token", want: true},
+		{name: "Deleted", token: "token", want: false},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Deleting multi-factor authentication challenge.
+			got, err := repos.DeleteChallenge(context.Background(), tt.token)
+			if err != nil {
+				t.Fatalf("error deleting challenge: %s", err.Error())
+			}
+
+			// Check for similarity of result.
+			if got != tt.want {
+				t.Errorf("error deleted = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
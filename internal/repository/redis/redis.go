@@ -25,7 +25,16 @@ import (
 )
 
 // Redis repository structure.
-type RedisRepository struct{ Code }
+type RedisRepository struct {
+	Code
+	Denylist
+	MFA
+	WebAuthn
+	Attempt
+	MagicLink
+	EmailRevert
+	Visit
+}
 
 // Creating a new redis repository.
 func NewRedisRepository(cfg config.RedisConfig) *RedisRepository {
@@ -37,5 +46,14 @@ func NewRedisRepository(cfg config.RedisConfig) *RedisRepository {
 		log.Fatal().Err(err).Msg("failed to create redis client")
 	}
 
-	return &RedisRepository{Code: NewCodeRepository(client)}
+	return &RedisRepository{
+		Code:        NewCodeRepository(client),
+		Denylist:    NewDenylistRepository(client),
+		MFA:         NewMFARepository(client),
+		WebAuthn:    NewWebAuthnRepository(client),
+		Attempt:     NewAttemptRepository(client),
+		MagicLink:   NewMagicLinkRepository(client),
+		EmailRevert: NewEmailRevertRepository(client),
+		Visit:       NewVisitRepository(client),
+	}
 }
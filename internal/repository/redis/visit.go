@@ -0,0 +1,59 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Redis module name.
+const VisitModule string = "visit"
+
+// User last visit debounce repository interface.
+type Visit interface {
+	Debounce(ctx context.Context, userId ksuid.KSUID, interval time.Duration) (bool, error)
+	Reset(ctx context.Context, userId ksuid.KSUID) error
+}
+
+// User last visit debounce repository structure.
+type VisitRepository struct{ redis redis.Redis }
+
+// Creating a new user last visit debounce repository.
+func NewVisitRepository(redis redis.Redis) *VisitRepository {
+	return &VisitRepository{redis: redis}
+}
+
+// Debouncing user last visit update, returns true only for the first visit in the interval.
+func (r *VisitRepository) Debounce(ctx context.Context, userId ksuid.KSUID, interval time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s:%s", VisitModule, userId)
+
+	return r.redis.SetNX(ctx, key, 1, interval).Result()
+}
+
+// Resetting user last visit debounce, so the next visit is updated again.
+func (r *VisitRepository) Reset(ctx context.Context, userId ksuid.KSUID) error {
+	key := fmt.Sprintf("%s:%s", VisitModule, userId)
+
+	return r.redis.Del(ctx, key).Err()
+}
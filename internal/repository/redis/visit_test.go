@@ -0,0 +1,117 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing debouncing user last visit update.
+func TestVisitRepository_Debounce(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewVisitRepository(client)
+
+	userId := ksuid.New()
+
+	// Tests structures.
+	tests := []struct {
+		name     string
+		userId   ksuid.KSUID
+		interval time.Duration
+		advance  time.Duration
+		want     bool
+	}{
+		{name: "First Visit", userId: userId, interval: time.Minute * 5, want: true},
+		{name: "Debounced", userId: userId, interval: time.Minute * 5, advance: time.Minute, want: false},
+		{name: "Other User", userId: ksuid.New(), interval: time.Minute * 5, want: true},
+		{name: "Interval Passed", userId: userId, interval: time.Minute * 5, advance: time.Minute * 5, want: true},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Debouncing user last visit update.
+			got, err := repos.Debounce(context.Background(), tt.userId, tt.interval)
+			if err != nil {
+				t.Fatalf("error debouncing last visit: %s", err.Error())
+			}
+
+			// Check debounce result.
+			if got != tt.want {
+				t.Errorf("error debounce result: got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+// Testing resetting user last visit debounce.
+func TestVisitRepository_Reset(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewVisitRepository(client)
+
+	userId := ksuid.New()
+
+	// Debouncing user last visit update.
+	if _, err := repos.Debounce(context.Background(), userId, time.Minute*5); err != nil {
+		t.Fatalf("error debouncing last visit: %s", err.Error())
+	}
+
+	// Resetting user last visit debounce.
+	if err := repos.Reset(context.Background(), userId); err != nil {
+		t.Fatalf("error resetting last visit debounce: %s", err.Error())
+	}
+
+	// Check that the next visit is not debounced.
+	got, err := repos.Debounce(context.Background(), userId, time.Minute*5)
+	if err != nil {
+		t.Fatalf("error debouncing last visit: %s", err.Error())
+	}
+
+	if !got {
+		t.Error("error last visit is debounced after reset")
+	}
+}
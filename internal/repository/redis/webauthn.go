@@ -0,0 +1,88 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Redis module name.
+const WebAuthnModule string = "webauthn"
+
+// WebAuthn ceremony challenge repository interface.
+type WebAuthn interface {
+	CreateChallenge(ctx context.Context, challenge string, session domain.WebAuthnSession, ttl time.Duration) error
+	ConsumeChallenge(ctx context.Context, challenge string) (domain.WebAuthnSession, error)
+}
+
+// WebAuthn ceremony challenge repository structure.
+type WebAuthnRepository struct{ redis redis.Redis }
+
+// Creating a new WebAuthn ceremony challenge repository.
+func NewWebAuthnRepository(redis redis.Redis) *WebAuthnRepository {
+	return &WebAuthnRepository{redis: redis}
+}
+
+// Creating a new WebAuthn ceremony challenge.
+func (r *WebAuthnRepository) CreateChallenge(ctx context.Context, challenge string, session domain.WebAuthnSession, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", WebAuthnModule, challenge)
+
+	// Setting ceremony session fields and expiration in a single round trip.
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "type", session.Type, "user_id", session.UserId.String())
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	})
+
+	return err
+}
+
+// Consuming a WebAuthn ceremony challenge, so it can be used only once.
+func (r *WebAuthnRepository) ConsumeChallenge(ctx context.Context, challenge string) (domain.WebAuthnSession, error) {
+	key := fmt.Sprintf("%s:%s", WebAuthnModule, challenge)
+
+	var fields *redis.StringStringMapCmd
+
+	// Getting and deleting ceremony session atomically.
+	if _, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		fields = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+
+		return nil
+	}); err != nil {
+		return domain.WebAuthnSession{}, err
+	} else if len(fields.Val()) == 0 {
+		return domain.WebAuthnSession{}, &domain.Error{Code: domain.CodeNotFound, Message: "Challenge not found"}
+	}
+
+	// Parsing ceremony user id.
+	userId, err := ksuid.Parse(fields.Val()["user_id"])
+	if err != nil {
+		return domain.WebAuthnSession{}, err
+	}
+
+	return domain.WebAuthnSession{Type: fields.Val()["type"], UserId: userId}, nil
+}
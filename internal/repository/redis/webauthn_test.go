@@ -0,0 +1,144 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	rdb "github.com/durudex/durudex-user-service/pkg/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// Testing consuming a WebAuthn ceremony challenge.
+func TestWebAuthnRepository_ConsumeChallenge(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewWebAuthnRepository(client)
+
+	session := domain.WebAuthnSession{Type: "registration", UserId: ksuid.New()}
+
+	// Creating new WebAuthn ceremony challenges.
+	for _, challenge := range []string{"challenge", "expiring"} {
+		if err := repos.CreateChallenge(context.Background(), challenge, session, time.Minute); err != nil {
+			t.Fatalf("error creating challenge: %s", err.Error())
+		}
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name      string
+		challenge string
+		advance   time.Duration
+		want      domain.WebAuthnSession
+		wantErr   bool
+	}{
+		{name: "Unknown", challenge: "unknown", wantErr: true},
+		{name: "OK", challenge: "challenge", want: session},
+		{name: "Consumed", challenge: "challenge", wantErr: true},
+		{name: "Expired", challenge: "expiring", advance: time.Minute, wantErr: true},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Advancing in-memory redis server time.
+			server.FastForward(tt.advance)
+
+			// Consuming WebAuthn ceremony challenge.
+			got, err := repos.ConsumeChallenge(context.Background(), tt.challenge)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error consuming challenge: %v", err)
+			}
+
+			// Check for similarity of a ceremony session.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("error ceremony session are not similar: %v", got)
+			}
+		})
+	}
+}
+
+// Testing concurrent consuming of a WebAuthn ceremony challenge.
+func TestWebAuthnRepository_ConsumeChallengeConcurrent(t *testing.T) {
+	// Starting a new in-memory redis server.
+	server := miniredis.RunT(t)
+
+	// Creating a new redis client.
+	client, err := rdb.NewClient("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("error creating a new redis client: %s", err.Error())
+	}
+
+	// Creating a new repository.
+	repos := redis.NewWebAuthnRepository(client)
+
+	// Number of simultaneous ceremonies.
+	const workers = 16
+
+	// Creating a new WebAuthn ceremony challenge.
+	if err := repos.CreateChallenge(context.Background(), "challenge", domain.WebAuthnSession{
+		Type:   "authentication",
+		UserId: ksuid.New(),
+	}, time.Minute); err != nil {
+		t.Fatalf("error creating challenge: %s", err.Error())
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		consumed int
+	)
+
+	// Consuming the same challenge simultaneously.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := repos.ConsumeChallenge(context.Background(), "challenge"); err == nil {
+				mu.Lock()
+				consumed++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Check that the challenge was consumed only once.
+	if consumed != 1 {
+		t.Errorf("error challenge consumed %d times, want 1", consumed)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/durudex/durudex-user-service/internal/config"
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+)
+
+// Sign in attempt service interface.
+type Attempt interface {
+	Check(ctx context.Context, username, ip string) error
+	Fail(ctx context.Context, username, ip string) error
+	Reset(ctx context.Context, username string) error
+	CheckUser(ctx context.Context, username string) error
+	FailUser(ctx context.Context, username string) error
+}
+
+// Sign in attempt service structure.
+type AttemptService struct {
+	repos redis.Attempt
+	cfg   *config.AttemptConfig
+}
+
+// Creating a new sign in attempt service.
+func NewAttemptService(repos redis.Attempt, cfg *config.AttemptConfig) *AttemptService {
+	return &AttemptService{repos: repos, cfg: cfg}
+}
+
+// Checking if sign in attempts are allowed for the username and ip address.
+func (s *AttemptService) Check(ctx context.Context, username, ip string) error {
+	// Checking failed username sign in attempts.
+	if err := s.CheckUser(ctx, username); err != nil {
+		return err
+	}
+
+	// Getting failed ip address sign in attempts.
+	if _, blocked, err := s.repos.Get(ctx, ipSubject(ip)); err != nil {
+		return err
+	} else if blocked > 0 {
+		return &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many sign in attempts"}
+	}
+
+	return nil
+}
+
+// Counting a failed sign in attempt for the username and ip address.
+func (s *AttemptService) Fail(ctx context.Context, username, ip string) error {
+	// Counting failed username sign in attempt.
+	if err := s.fail(ctx, userSubject(username), s.cfg.Threshold); err != nil {
+		return err
+	}
+
+	// Counting failed ip address sign in attempt.
+	return s.fail(ctx, ipSubject(ip), s.cfg.IPThreshold)
+}
+
+// Checking if password attempts are allowed for the username, regardless of the ip address.
+func (s *AttemptService) CheckUser(ctx context.Context, username string) error {
+	// Getting failed username sign in attempts.
+	failures, blocked, err := s.repos.Get(ctx, userSubject(username))
+	if err != nil {
+		return err
+	} else if blocked > 0 {
+		// Check if the account is locked.
+		if failures >= s.cfg.Threshold {
+			return &domain.Error{Code: domain.CodeLocked, Message: "Account locked"}
+		}
+
+		return &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many sign in attempts"}
+	}
+
+	return nil
+}
+
+// Counting a failed password attempt for the username only.
+func (s *AttemptService) FailUser(ctx context.Context, username string) error {
+	return s.fail(ctx, userSubject(username), s.cfg.Threshold)
+}
+
+// Resetting failed username sign in attempts after successful sign in.
+func (s *AttemptService) Reset(ctx context.Context, username string) error {
+	return s.repos.Reset(ctx, userSubject(username))
+}
+
+// Counting a failed sign in attempt and blocking the next attempts.
+func (s *AttemptService) fail(ctx context.Context, subject string, threshold int64) error {
+	_, err := s.repos.Fail(ctx, subject, redis.AttemptPolicy{
+		Window:    s.cfg.Window,
+		Threshold: threshold,
+		BaseDelay: s.cfg.BaseDelay,
+		MaxDelay:  s.cfg.MaxDelay,
+		Lockout:   s.cfg.Lockout,
+	})
+
+	return err
+}
+
+// Getting username sign in attempt subject.
+func userSubject(username string) string { return "user:" + username }
+
+// Getting ip address sign in attempt subject.
+func ipSubject(ip string) string { return "ip:" + ip }
@@ -19,49 +19,126 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/durudex/durudex-user-service/internal/config"
 	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
 	"github.com/durudex/durudex-user-service/pkg/auth"
+	"github.com/durudex/durudex-user-service/pkg/hash"
 	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
+	"github.com/durudex/durudex-user-service/pkg/totp"
 
+	"github.com/golang-jwt/jwt"
+	"github.com/rs/zerolog/log"
 	"github.com/segmentio/ksuid"
 )
 
+// Unverified user sign in policies.
+const (
+	UnverifiedAllow   = "allow"
+	UnverifiedDeny    = "deny"
+	UnverifiedLimited = "limited"
+)
+
 // Auth service interface.
 type Auth interface {
 	SignUp(ctx context.Context, user domain.User, code uint64, ip string) (domain.Tokens, error)
-	SignIn(ctx context.Context, username, password, ip string) (domain.Tokens, error)
+	SignIn(ctx context.Context, username, password, ip string) (domain.SignIn, error)
+	Authenticate(ctx context.Context, user domain.User, ip string) (domain.SignIn, error)
+	VerifyMFA(ctx context.Context, token, code, ip string) (domain.Tokens, error)
 	SignOut(ctx context.Context, token, ip string) error
-	RefreshTokens(ctx context.Context, token, ip string) (string, error)
+	RefreshTokens(ctx context.Context, token, ip string) (domain.Tokens, error)
 	CreateSession(ctx context.Context, id ksuid.KSUID, ip string) (domain.Tokens, error)
+	ValidateAccessToken(ctx context.Context, token string) (domain.AccessToken, error)
+	GetJWKS() []auth.JWK
+	GetSessions(ctx context.Context, userId ksuid.KSUID) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, id, userId ksuid.KSUID) error
+	RevokeAllSessions(ctx context.Context, userId, except ksuid.KSUID) error
 }
 
 // Auth service structure.
 type AuthService struct {
-	user    User
-	code    Code
-	email   v1.EmailUserServiceClient
-	session postgres.Session
-	cfg     *config.AuthConfig
+	user      User
+	code      Code
+	email     v1.EmailUserServiceClient
+	session   postgres.Session
+	revoke    Revoke
+	mfa       MFA
+	attempt   Attempt
+	visit     Visit
+	challenge redis.MFA
+	keys      *auth.KeySet
+	cfg       *config.AuthConfig
+}
+
+// Creating a new jwt key set from config.
+func newKeySet(cfg config.JWTConfig) (*auth.KeySet, error) {
+	// Using the symmetric signing key if asymmetric keys are not configured.
+	if len(cfg.Keys) == 0 {
+		key, err := auth.NewKey("default", auth.AlgorithmHS256, []byte(cfg.SigningKey), time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+
+		return auth.NewKeySet(key)
+	}
+
+	keys := make([]auth.Key, len(cfg.Keys))
+
+	for i, k := range cfg.Keys {
+		// Reading PEM encoded private key file.
+		data, err := os.ReadFile(k.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i], err = auth.NewKey(k.Id, k.Algorithm, data, k.ActiveFrom, k.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// Tokens signed just before the key expires are verified for the rest of their ttl.
+		if !k.ExpiresAt.IsZero() {
+			keys[i].VerifyUntil = k.ExpiresAt.Add(cfg.TTL)
+		}
+	}
+
+	return auth.NewKeySet(keys...)
 }
 
 // User Sign Up.
 func (s *AuthService) SignUp(ctx context.Context, user domain.User, code uint64, ip string) (domain.Tokens, error) {
-	// Verifying user email code.
-	status, err := s.code.VerifyEmailCode(ctx, user.Email, code)
+	// Checking user email code without consuming it.
+	status, err := s.code.CheckEmailCode(ctx, user.Email, domain.CodePurposeSignUp, code)
 	if err != nil || !status {
 		return domain.Tokens{}, err
 	}
 
+	// The email address is confirmed by the sign up code.
+	user.Verified = true
+
 	// Creating a new user.
 	id, err := s.user.Create(ctx, user)
 	if err != nil {
 		return domain.Tokens{}, err
 	}
 
+	// Consuming user email code after the user has been created.
+	if _, err := s.code.ConsumeEmailCode(ctx, user.Email, domain.CodePurposeSignUp, code); err != nil {
+		// Deleting the user if the code was consumed by a concurrent sign up.
+		if err := s.user.Delete(ctx, id); err != nil {
+			return domain.Tokens{}, err
+		}
+
+		return domain.Tokens{}, err
+	}
+
 	// Creating a new user session.
 	tokens, err := s.CreateSession(ctx, id, ip)
 	if err != nil {
@@ -80,22 +157,162 @@ func (s *AuthService) SignUp(ctx context.Context, user domain.User, code uint64,
 }
 
 // User Sign In.
-func (s *AuthService) SignIn(ctx context.Context, username, password, ip string) (domain.Tokens, error) {
-	// Getting a user by credentials.
-	user, err := s.user.GetByCreds(ctx, username, password)
+func (s *AuthService) SignIn(ctx context.Context, username, password, ip string) (domain.SignIn, error) {
+	// Verifying user credentials, failed attempts are counted and soft deleted users are restored
+	// by the session creation.
+	user, err := s.user.VerifyCreds(ctx, username, password, ip)
+	if err != nil {
+		return domain.SignIn{}, err
+	}
+
+	// Signing in the user, the second factor is required if enabled.
+	result, err := s.Authenticate(ctx, user, ip)
+	if err != nil {
+		return domain.SignIn{}, err
+	}
+
+	// Resetting failed sign in attempts only when no second factor is pending, so a new challenge
+	// does not get a new attempt budget.
+	if result.MFAToken == "" {
+		if err := s.attempt.Reset(ctx, username); err != nil {
+			return domain.SignIn{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// Signing in a user with a verified first factor, the second factor is required if enabled.
+func (s *AuthService) Authenticate(ctx context.Context, user domain.User, ip string) (domain.SignIn, error) {
+	// Check if the user has multi-factor authentication enabled.
+	enabled, err := s.mfa.IsEnabled(ctx, user.Id)
+	if err != nil {
+		return domain.SignIn{}, err
+	} else if enabled {
+		// Generating a new multi-factor authentication challenge token.
+		token, err := auth.GenerateRefreshToken()
+		if err != nil {
+			return domain.SignIn{}, err
+		}
+
+		// Creating a new short-lived multi-factor authentication challenge.
+		if err := s.challenge.CreateChallenge(ctx, hash.Token(token, s.cfg.Session.HashKey), domain.MFAChallenge{
+			UserId:   user.Id,
+			Username: user.Username,
+			Email:    user.Email,
+		}, s.cfg.MFA.ChallengeTTL); err != nil {
+			return domain.SignIn{}, err
+		}
+
+		return domain.SignIn{MFAToken: token}, nil
+	}
+
+	// Creating a new user session.
+	tokens, err := s.signIn(ctx, user.Id, user.Email, ip)
+	if err != nil {
+		return domain.SignIn{}, err
+	}
+
+	return domain.SignIn{Tokens: tokens}, nil
+}
+
+// Verifying a user multi-factor authentication challenge.
+func (s *AuthService) VerifyMFA(ctx context.Context, token, code, ip string) (domain.Tokens, error) {
+	key := hash.Token(token, s.cfg.Session.HashKey)
+
+	// Getting a multi-factor authentication challenge.
+	challenge, err := s.challenge.GetChallenge(ctx, key)
+	if err != nil {
+		var e *domain.Error
+
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return domain.Tokens{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid MFA Token"}
+		}
+
+		return domain.Tokens{}, err
+	}
+
+	// Check if second factor attempts are not blocked for the user.
+	if err := s.attempt.CheckUser(ctx, challenge.Username); err != nil {
+		return domain.Tokens{}, err
+	}
+
+	// Verifying a user second factor code.
+	if err := s.verifySecondFactor(ctx, challenge, code); err != nil {
+		var e *domain.Error
+
+		// Counting failed user and challenge attempt with an invalid code.
+		if errors.As(err, &e) && (e.Code == domain.CodeUnauthenticated || e.Code == domain.CodeInvalidArgument ||
+			e.Code == domain.CodeNotFound) {
+			if err := s.attempt.FailUser(ctx, challenge.Username); err != nil {
+				return domain.Tokens{}, err
+			}
+
+			failures, err := s.challenge.FailChallenge(ctx, key, s.cfg.MFA.MaxAttempts)
+			if err != nil {
+				return domain.Tokens{}, err
+			} else if failures >= s.cfg.MFA.MaxAttempts {
+				return domain.Tokens{}, &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many MFA attempts"}
+			}
+		}
+
+		return domain.Tokens{}, err
+	}
+
+	// Deleting the challenge, so it can be used only once.
+	deleted, err := s.challenge.DeleteChallenge(ctx, key)
 	if err != nil {
 		return domain.Tokens{}, err
+	} else if !deleted {
+		return domain.Tokens{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid MFA Token"}
+	}
+
+	// Resetting failed sign in attempts after both factors are verified.
+	if err := s.attempt.Reset(ctx, challenge.Username); err != nil {
+		return domain.Tokens{}, err
 	}
 
 	// Creating a new user session.
-	tokens, err := s.CreateSession(ctx, user.Id, ip)
+	return s.signIn(ctx, challenge.UserId, challenge.Email, ip)
+}
+
+// Verifying a user TOTP or recovery code.
+func (s *AuthService) verifySecondFactor(ctx context.Context, challenge domain.MFAChallenge, code string) error {
+	// TOTP codes are numeric, so anything else is treated as a recovery code.
+	if len(code) == totp.Digits {
+		return s.mfa.VerifyTOTP(ctx, challenge.UserId, code)
+	}
+
+	// Verifying a user recovery code.
+	remaining, err := s.mfa.VerifyRecoveryCode(ctx, challenge.UserId, code)
+	if err != nil {
+		return err
+	}
+
+	// Sending an email to a user with all recovery codes used.
+	if remaining == 0 {
+		if _, err := s.email.SendEmailUserRecoveryCodesUsed(ctx, &v1.SendEmailUserRecoveryCodesUsedRequest{
+			Email: challenge.Email,
+		}); err != nil {
+			// The recovery code is already used, so the sign in must not fail.
+			log.Warn().Err(err).Msg("failed to send recovery codes used email")
+		}
+	}
+
+	return nil
+}
+
+// Creating a new user session and notifying the user about sign in.
+func (s *AuthService) signIn(ctx context.Context, id ksuid.KSUID, email, ip string) (domain.Tokens, error) {
+	// Creating a new user session.
+	tokens, err := s.CreateSession(ctx, id, ip)
 	if err != nil {
 		return domain.Tokens{}, err
 	}
 
 	// Sending an email to a user with logged in.
 	if _, err := s.email.SendEmailUserLoggedIn(ctx, &v1.SendEmailUserLoggedInRequest{
-		Email: user.Email,
+		Email: email,
 		Ip:    ip,
 	}); err != nil {
 		return domain.Tokens{}, err
@@ -106,31 +323,103 @@ func (s *AuthService) SignIn(ctx context.Context, username, password, ip string)
 
 // User Sign Out.
 func (s *AuthService) SignOut(ctx context.Context, token, ip string) error {
-	// Deleting a user session by refresh token and ip address.
-	return s.session.Delete(ctx, token, ip)
+	// Getting a user session by refresh token and ip address.
+	session, err := s.getSession(ctx, token, ip)
+	if err != nil {
+		var e *domain.Error
+
+		// Sign out of a non-existent session has nothing to delete.
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	// Revoking a user session family.
+	return s.revoke.Family(ctx, session.FamilyId)
 }
 
-// Refresh user session access token.
-func (s *AuthService) RefreshTokens(ctx context.Context, token, ip string) (string, error) {
-	// Getting a user id in session by refresh token and ip address.
-	id, err := s.session.GetUserId(ctx, token, ip)
+// Refresh user session tokens.
+func (s *AuthService) RefreshTokens(ctx context.Context, token, ip string) (domain.Tokens, error) {
+	// Getting a user session by refresh token and ip address.
+	session, err := s.getSession(ctx, token, ip)
 	if err != nil {
-		return "", err
+		return domain.Tokens{}, err
+	}
+
+	// Check if the refresh token has already been rotated out.
+	if session.Rotated {
+		// Revoking the whole session family because the refresh token was reused.
+		if err := s.revoke.Family(ctx, session.FamilyId); err != nil {
+			return domain.Tokens{}, err
+		}
+
+		return domain.Tokens{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Refresh token reused"}
+	}
+
+	// Getting access token scopes of the user.
+	scopes, err := s.scopes(ctx, session.UserId)
+	if err != nil {
+		return domain.Tokens{}, err
 	}
 
 	// Generating a new jwt access token.
-	accessToken, err := auth.GenerateAccessToken(id.String(), s.cfg.JWT.SigningKey, s.cfg.JWT.TTL)
+	accessToken, err := s.generateAccessToken(session.UserId, session.FamilyId, scopes)
 	if err != nil {
-		return "", err
+		return domain.Tokens{}, err
 	}
 
-	return accessToken, nil
+	// Generating a new refresh token.
+	refreshToken, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return domain.Tokens{}, err
+	}
+
+	// Rotating a user session refresh token.
+	if err := s.session.Rotate(ctx, session.Id, domain.Session{
+		Id:           ksuid.New(),
+		UserId:       session.UserId,
+		FamilyId:     session.FamilyId,
+		RefreshToken: hash.Token(refreshToken, s.cfg.Session.HashKey),
+		Ip:           ip,
+		ExpiresIn:    time.Now().Add(s.cfg.Session.TTL),
+	}); err != nil {
+		var e *domain.Error
+
+		// Revoking the whole session family if the refresh token was concurrently reused.
+		if errors.As(err, &e) && e.Code == domain.CodeUnauthenticated {
+			if err := s.revoke.Family(ctx, session.FamilyId); err != nil {
+				return domain.Tokens{}, err
+			}
+		}
+
+		return domain.Tokens{}, err
+	}
+
+	// Updating user last visit.
+	s.touch(ctx, session.UserId)
+
+	return domain.Tokens{Access: accessToken, Refresh: refreshToken}, nil
 }
 
 // Creating a new user session.
 func (s *AuthService) CreateSession(ctx context.Context, id ksuid.KSUID, ip string) (domain.Tokens, error) {
+	sessionId := ksuid.New()
+
+	// Restoring the user if it has been deleted during the grace period.
+	if err := s.user.Restore(ctx, id); err != nil {
+		return domain.Tokens{}, err
+	}
+
+	// Getting access token scopes of the user.
+	scopes, err := s.scopes(ctx, id)
+	if err != nil {
+		return domain.Tokens{}, err
+	}
+
 	// Generating a new jwt access token.
-	accessToken, err := auth.GenerateAccessToken(id.String(), s.cfg.JWT.SigningKey, s.cfg.JWT.TTL)
+	accessToken, err := s.generateAccessToken(id, sessionId, scopes)
 	if err != nil {
 		return domain.Tokens{}, err
 	}
@@ -143,14 +432,157 @@ func (s *AuthService) CreateSession(ctx context.Context, id ksuid.KSUID, ip stri
 
 	// Creating a new user session.
 	if err := s.session.Create(ctx, domain.Session{
-		Id:           ksuid.New(),
+		Id:           sessionId,
 		UserId:       id,
-		RefreshToken: refreshToken,
+		FamilyId:     sessionId,
+		RefreshToken: hash.Token(refreshToken, s.cfg.Session.HashKey),
 		Ip:           ip,
 		ExpiresIn:    time.Now().Add(s.cfg.Session.TTL),
 	}); err != nil {
 		return domain.Tokens{}, err
 	}
 
+	// Updating user last visit.
+	s.touch(ctx, id)
+
 	return domain.Tokens{Access: accessToken, Refresh: refreshToken}, nil
 }
+
+// Updating user last visit, failure does not interrupt authentication.
+func (s *AuthService) touch(ctx context.Context, userId ksuid.KSUID) {
+	if err := s.visit.Touch(ctx, userId); err != nil {
+		log.Warn().Err(err).Msg("failed to update user last visit")
+	}
+}
+
+// Getting access token scopes according to the unverified user sign in policy.
+func (s *AuthService) scopes(ctx context.Context, id ksuid.KSUID) ([]string, error) {
+	// Verified status does not matter if unverified users are allowed.
+	if s.cfg.Verification.Unverified != UnverifiedDeny && s.cfg.Verification.Unverified != UnverifiedLimited {
+		return s.cfg.JWT.Scopes, nil
+	}
+
+	// Getting user by id.
+	user, err := s.user.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if user.Verified {
+		return s.cfg.JWT.Scopes, nil
+	}
+
+	// Denying sign in of unverified users.
+	if s.cfg.Verification.Unverified == UnverifiedDeny {
+		return nil, &domain.Error{Code: domain.CodeFailedPrecondition, Message: "User is not verified"}
+	}
+
+	return s.cfg.Verification.Scopes, nil
+}
+
+// Generating a new jwt access token with the current signing key.
+func (s *AuthService) generateAccessToken(id, sessionId ksuid.KSUID, scopes []string) (string, error) {
+	// Getting the current jwt signing key.
+	key, err := s.keys.Signing(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	return auth.GenerateAccessToken(auth.Claims{
+		StandardClaims: jwt.StandardClaims{
+			Id:       ksuid.New().String(),
+			Issuer:   s.cfg.JWT.Issuer,
+			Audience: s.cfg.JWT.Audience,
+			Subject:  id.String(),
+		},
+		SessionId: sessionId.String(),
+		Roles:     s.cfg.JWT.Roles,
+		Scopes:    scopes,
+	}, key, s.cfg.JWT.TTL)
+}
+
+// Validating a jwt access token.
+func (s *AuthService) ValidateAccessToken(ctx context.Context, token string) (domain.AccessToken, error) {
+	// Parsing and validating a jwt access token.
+	claims, err := auth.ParseAccessToken(token, s.keys)
+	if err != nil {
+		return domain.AccessToken{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Token"}
+	}
+
+	// Check token issuer and audience.
+	if !claims.VerifyIssuer(s.cfg.JWT.Issuer, s.cfg.JWT.Issuer != "") ||
+		!claims.VerifyAudience(s.cfg.JWT.Audience, s.cfg.JWT.Audience != "") {
+		return domain.AccessToken{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Token"}
+	}
+
+	// Getting token, user and session ids from claims.
+	id, err := ksuid.Parse(claims.Id)
+	if err != nil {
+		return domain.AccessToken{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Token"}
+	}
+	userId, err := ksuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.AccessToken{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Token"}
+	}
+	sessionId, err := ksuid.Parse(claims.SessionId)
+	if err != nil {
+		return domain.AccessToken{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Token"}
+	}
+
+	// Check if the user session has not been revoked.
+	revoked, err := s.revoke.IsRevoked(ctx, sessionId)
+	if err != nil {
+		return domain.AccessToken{}, err
+	} else if revoked {
+		return domain.AccessToken{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Token revoked"}
+	}
+
+	// Updating user last visit.
+	s.touch(ctx, userId)
+
+	return domain.AccessToken{
+		Id:        id,
+		UserId:    userId,
+		SessionId: sessionId,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+		Roles:     claims.Roles,
+		Scopes:    claims.Scopes,
+	}, nil
+}
+
+// Getting public jwt keys.
+func (s *AuthService) GetJWKS() []auth.JWK {
+	return s.keys.JWKS(time.Now())
+}
+
+// Getting a user session by refresh token.
+func (s *AuthService) getSession(ctx context.Context, token, ip string) (domain.Session, error) {
+	// Getting a user session by keyed refresh token hash.
+	session, err := s.session.Get(ctx, hash.Token(token, s.cfg.Session.HashKey), ip)
+	if err != nil {
+		var e *domain.Error
+
+		// Sessions created before refresh tokens were keyed are stored as a plain
+		// SHA-256 hash by the schema migration, accepted only until the deadline.
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound && time.Now().Before(s.cfg.Session.LegacyHashUntil) {
+			return s.session.Get(ctx, fmt.Sprintf("%x", sha256.Sum256([]byte(token))), ip)
+		}
+
+		return domain.Session{}, err
+	}
+
+	return session, nil
+}
+
+// Getting all active user sessions.
+func (s *AuthService) GetSessions(ctx context.Context, userId ksuid.KSUID) ([]domain.Session, error) {
+	return s.session.GetAll(ctx, userId)
+}
+
+// Revoking a user session.
+func (s *AuthService) RevokeSession(ctx context.Context, id, userId ksuid.KSUID) error {
+	return s.revoke.Session(ctx, id, userId)
+}
+
+// Revoking all user sessions except one.
+func (s *AuthService) RevokeAllSessions(ctx context.Context, userId, except ksuid.KSUID) error {
+	return s.revoke.All(ctx, userId, except)
+}
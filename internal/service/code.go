@@ -29,8 +29,10 @@ import (
 
 // Code service interface.
 type Code interface {
-	CreateVerifyEmailCode(ctx context.Context, email string) error
-	VerifyEmailCode(ctx context.Context, email string, input uint64) (bool, error)
+	CreateVerifyEmailCode(ctx context.Context, email, ip string, purpose domain.CodePurpose) error
+	VerifyEmailCode(ctx context.Context, email string, purpose domain.CodePurpose, input uint64) (bool, error)
+	CheckEmailCode(ctx context.Context, email string, purpose domain.CodePurpose, input uint64) (bool, error)
+	ConsumeEmailCode(ctx context.Context, email string, purpose domain.CodePurpose, input uint64) (bool, error)
 }
 
 // Code service structure.
@@ -46,15 +48,39 @@ func NewCodeService(repos redis.Code, email v1.EmailUserServiceClient, cfg *conf
 }
 
 // Creating a new user verification email code.
-func (s *CodeService) CreateVerifyEmailCode(ctx context.Context, email string) error {
+func (s *CodeService) CreateVerifyEmailCode(ctx context.Context, email, ip string, purpose domain.CodePurpose) error {
+	// Validate code purpose.
+	if err := purpose.Validate(); err != nil {
+		return err
+	}
+
+	// Counting codes sent to the email address.
+	sends, err := s.repos.IncrementSends(ctx, "email:"+email, s.cfg.SendWindow)
+	if err != nil {
+		return err
+	} else if sends > s.cfg.SendLimit {
+		return &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many codes requested"}
+	}
+
+	// Counting codes requested from the ip address.
+	sends, err = s.repos.IncrementSends(ctx, "ip:"+ip, s.cfg.SendWindow)
+	if err != nil {
+		return err
+	} else if sends > s.cfg.IPSendLimit {
+		return &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many codes requested"}
+	}
+
+	// Getting code settings of the purpose.
+	cfg := s.purpose(purpose)
+
 	// Generate random code.
-	code, err := rand.Generate(s.cfg.MaxLength, s.cfg.MinLength)
+	code, err := rand.Generate(cfg.MaxLength, cfg.MinLength)
 	if err != nil {
 		return err
 	}
 
 	// Creating a new code.
-	if err := s.repos.CreateByEmail(ctx, email, code, s.cfg.TTL); err != nil {
+	if err := s.repos.CreateByEmail(ctx, email, purpose.String(), code, cfg.TTL); err != nil {
 		return err
 	}
 
@@ -71,18 +97,86 @@ func (s *CodeService) CreateVerifyEmailCode(ctx context.Context, email string) e
 	return nil
 }
 
-// Verifying a user verification email code.
-func (s *CodeService) VerifyEmailCode(ctx context.Context, email string, input uint64) (bool, error) {
-	// Getting code by email.
-	code, err := s.repos.GetByEmail(ctx, email)
+// Verifying and consuming a user verification email code.
+func (s *CodeService) VerifyEmailCode(ctx context.Context, email string, purpose domain.CodePurpose, input uint64) (bool, error) {
+	// Checking user verification email code.
+	if status, err := s.CheckEmailCode(ctx, email, purpose, input); err != nil || !status {
+		return false, err
+	}
+
+	// Consuming the code so that it can be used only once.
+	return s.ConsumeEmailCode(ctx, email, purpose, input)
+}
+
+// Consuming a user verification email code.
+func (s *CodeService) ConsumeEmailCode(ctx context.Context, email string, purpose domain.CodePurpose, input uint64) (bool, error) {
+	// Validate code purpose.
+	if err := purpose.Validate(); err != nil {
+		return false, err
+	}
+
+	// Atomically comparing and deleting the code.
+	consumed, err := s.repos.ConsumeByEmail(ctx, email, purpose.String(), input)
 	if err != nil {
 		return false, err
+	} else if !consumed {
+		return false, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Code"}
+	}
+
+	return true, nil
+}
+
+// Checking a user verification email code without consuming it.
+func (s *CodeService) CheckEmailCode(ctx context.Context, email string, purpose domain.CodePurpose, input uint64) (bool, error) {
+	// Validate code purpose.
+	if err := purpose.Validate(); err != nil {
+		return false, err
+	}
+
+	// Getting code by email and purpose.
+	code, err := s.repos.GetByEmail(ctx, email, purpose.String())
+	if err != nil {
+		return false, err
+	}
+
+	// Counting verification attempts of the code.
+	attempts, err := s.repos.IncrementAttempts(ctx, email, purpose.String(), s.purpose(purpose).TTL)
+	if err != nil {
+		return false, err
+	} else if attempts > s.cfg.MaxAttempts {
+		// Invalidating the code after too many attempts.
+		if err := s.repos.DeleteByEmail(ctx, email, purpose.String()); err != nil {
+			return false, err
+		}
+
+		return false, &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many attempts"}
 	}
 
 	// Check input code.
 	if input != code {
+		// Invalidating the code after the last allowed attempt.
+		if attempts == s.cfg.MaxAttempts {
+			if err := s.repos.DeleteByEmail(ctx, email, purpose.String()); err != nil {
+				return false, err
+			}
+		}
+
 		return false, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Code"}
 	}
 
 	return true, nil
 }
+
+// Getting code settings of the purpose, unset values fall back to the defaults.
+func (s *CodeService) purpose(purpose domain.CodePurpose) config.CodePurposeConfig {
+	cfg := s.cfg.Purposes[purpose.String()]
+
+	if cfg.TTL == 0 {
+		cfg.TTL = s.cfg.TTL
+	}
+	if cfg.MaxLength == 0 || cfg.MinLength == 0 {
+		cfg.MaxLength, cfg.MinLength = s.cfg.MaxLength, s.cfg.MinLength
+	}
+
+	return cfg
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/durudex/durudex-user-service/internal/config"
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	"github.com/durudex/durudex-user-service/pkg/auth"
+	"github.com/durudex/durudex-user-service/pkg/hash"
+	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
+)
+
+// User magic sign in link service interface.
+type MagicLink interface {
+	Request(ctx context.Context, username, ip string) error
+	Redeem(ctx context.Context, token, ip string) (domain.SignIn, error)
+}
+
+// User magic sign in link service structure.
+type MagicLinkService struct {
+	user  postgres.User
+	link  redis.MagicLink
+	auth  Auth
+	email v1.EmailUserServiceClient
+	cfg   *config.AuthConfig
+}
+
+// Creating a new user magic sign in link service.
+func NewMagicLinkService(user postgres.User, link redis.MagicLink, auth Auth, email v1.EmailUserServiceClient, cfg *config.AuthConfig) *MagicLinkService {
+	return &MagicLinkService{user: user, link: link, auth: auth, email: email, cfg: cfg}
+}
+
+// Requesting a user magic sign in link.
+func (s *MagicLinkService) Request(ctx context.Context, username, ip string) error {
+	// Counting magic links requested from the ip address.
+	sends, err := s.link.IncrementSends(ctx, "ip:"+ip, s.cfg.MagicLink.SendWindow)
+	if err != nil {
+		return err
+	} else if sends > s.cfg.MagicLink.IPSendLimit {
+		return &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many magic links requested"}
+	}
+
+	// Counting magic links requested for the username, unknown usernames are counted too.
+	sends, err = s.link.IncrementSends(ctx, "user:"+username, s.cfg.MagicLink.SendWindow)
+	if err != nil {
+		return err
+	} else if sends > s.cfg.MagicLink.SendLimit {
+		return &domain.Error{Code: domain.CodeTooManyAttempts, Message: "Too many magic links requested"}
+	}
+
+	// Getting user by username.
+	user, err := s.user.GetByUsername(ctx, username)
+	if err != nil {
+		var e *domain.Error
+
+		// Unknown usernames are not reported, so they cannot be enumerated.
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	// Generating a new magic link token.
+	token, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	// Creating a new magic link bound to the requesting ip address.
+	if err := s.link.Create(ctx, hash.Token(token, s.cfg.Session.HashKey), domain.MagicLink{
+		UserId: user.Id,
+		Ip:     ip,
+	}, s.cfg.MagicLink.TTL); err != nil {
+		return err
+	}
+
+	// Building magic link url.
+	link, err := buildLink(s.cfg.MagicLink.URL, token)
+	if err != nil {
+		return err
+	}
+
+	// Sending an email to a user with a magic sign in link.
+	if _, err := s.email.SendEmailUserMagicLink(ctx, &v1.SendEmailUserMagicLinkRequest{
+		Email:    user.Email,
+		Username: username,
+		Link:     link,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Redeeming a user magic sign in link, the second factor is required if enabled.
+func (s *MagicLinkService) Redeem(ctx context.Context, token, ip string) (domain.SignIn, error) {
+	// Consuming a magic link, so it can be used only once.
+	link, err := s.link.Consume(ctx, hash.Token(token, s.cfg.Session.HashKey))
+	if err != nil {
+		var e *domain.Error
+
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return domain.SignIn{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Magic Link"}
+		}
+
+		return domain.SignIn{}, err
+	}
+
+	// Check that the link is redeemed from the ip address it was requested from.
+	if link.Ip != ip {
+		return domain.SignIn{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Magic Link"}
+	}
+
+	// Getting user by id.
+	user, err := s.user.GetByID(ctx, link.UserId)
+	if err != nil {
+		return domain.SignIn{}, err
+	}
+	user.Id = link.UserId
+
+	// Signing in the user, the magic link replaces only the password.
+	return s.auth.Authenticate(ctx, user, ip)
+}
@@ -0,0 +1,232 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/config"
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/pkg/crypto/aes"
+	"github.com/durudex/durudex-user-service/pkg/crypto/rand"
+	"github.com/durudex/durudex-user-service/pkg/hash"
+	"github.com/durudex/durudex-user-service/pkg/totp"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Multi-factor authentication service interface.
+type MFA interface {
+	EnrollTOTP(ctx context.Context, userId ksuid.KSUID) (domain.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userId ksuid.KSUID, code string) error
+	IsEnabled(ctx context.Context, userId ksuid.KSUID) (bool, error)
+	VerifyTOTP(ctx context.Context, userId ksuid.KSUID, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userId ksuid.KSUID) ([]string, error)
+	VerifyRecoveryCode(ctx context.Context, userId ksuid.KSUID, code string) (int, error)
+}
+
+// Length of a recovery code without the separator.
+const recoveryCodeLength int = 10
+
+// Purpose of the key derived for hashing recovery codes, so the encryption key is not reused.
+const recoveryKeyPurpose string = "durudex recovery code"
+
+// Multi-factor authentication service structure.
+type MFAService struct {
+	user        User
+	totp        postgres.TOTP
+	recovery    postgres.RecoveryCode
+	recoveryKey string
+	cfg         *config.MFAConfig
+	clock       func() time.Time
+}
+
+// Creating a new multi-factor authentication service.
+func NewMFAService(user User, totp postgres.TOTP, recovery postgres.RecoveryCode, cfg *config.MFAConfig, clock func() time.Time) *MFAService {
+	return &MFAService{
+		user:        user,
+		totp:        totp,
+		recovery:    recovery,
+		recoveryKey: hash.DeriveKey(cfg.EncryptionKey, recoveryKeyPurpose),
+		cfg:         cfg,
+		clock:       clock,
+	}
+}
+
+// Enrolling a new user TOTP authenticator.
+func (s *MFAService) EnrollTOTP(ctx context.Context, userId ksuid.KSUID) (domain.TOTPEnrollment, error) {
+	// Getting user by id.
+	user, err := s.user.GetByID(ctx, userId)
+	if err != nil {
+		return domain.TOTPEnrollment{}, err
+	}
+
+	// Generating a new TOTP secret.
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return domain.TOTPEnrollment{}, err
+	}
+
+	// Encrypting TOTP secret.
+	encrypted, err := aes.Encrypt([]byte(secret), s.cfg.EncryptionKey)
+	if err != nil {
+		return domain.TOTPEnrollment{}, err
+	}
+
+	// Creating a new unconfirmed user TOTP authenticator.
+	if err := s.totp.Create(ctx, userId, encrypted); err != nil {
+		return domain.TOTPEnrollment{}, err
+	}
+
+	// Generating new user recovery codes.
+	codes, err := s.generateRecoveryCodes(ctx, userId)
+	if err != nil {
+		return domain.TOTPEnrollment{}, err
+	}
+
+	return domain.TOTPEnrollment{
+		Secret:        secret,
+		URI:           totp.URI(secret, s.cfg.Issuer, user.Username),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// Confirming a user TOTP authenticator with the first code.
+func (s *MFAService) ConfirmTOTP(ctx context.Context, userId ksuid.KSUID, code string) error {
+	// Getting user TOTP authenticator.
+	authenticator, err := s.totp.Get(ctx, userId)
+	if err != nil {
+		return err
+	} else if authenticator.Confirmed {
+		return &domain.Error{Code: domain.CodeAlreadyExists, Message: "TOTP already enabled"}
+	}
+
+	// Validating TOTP code.
+	step, err := s.validate(authenticator, code)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Code"}
+	}
+
+	// Confirming user TOTP authenticator.
+	return s.totp.Confirm(ctx, userId, step)
+}
+
+// Checking if the user has multi-factor authentication enabled.
+func (s *MFAService) IsEnabled(ctx context.Context, userId ksuid.KSUID) (bool, error) {
+	// Getting user TOTP authenticator.
+	authenticator, err := s.totp.Get(ctx, userId)
+	if err != nil {
+		var e *domain.Error
+
+		// Users without an authenticator have multi-factor authentication disabled.
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return authenticator.Confirmed, nil
+}
+
+// Verifying a user TOTP code.
+func (s *MFAService) VerifyTOTP(ctx context.Context, userId ksuid.KSUID, code string) error {
+	// Getting user TOTP authenticator.
+	authenticator, err := s.totp.Get(ctx, userId)
+	if err != nil {
+		return err
+	} else if !authenticator.Confirmed {
+		return &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Code"}
+	}
+
+	// Validating TOTP code.
+	step, err := s.validate(authenticator, code)
+	if err != nil {
+		return &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Code"}
+	}
+
+	// Using TOTP time step, so the code cannot be replayed.
+	return s.totp.UseStep(ctx, userId, step)
+}
+
+// Regenerating user recovery codes.
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, userId ksuid.KSUID) ([]string, error) {
+	// Check if the user has multi-factor authentication enabled.
+	enabled, err := s.IsEnabled(ctx, userId)
+	if err != nil {
+		return nil, err
+	} else if !enabled {
+		return nil, &domain.Error{Code: domain.CodeInvalidArgument, Message: "MFA not enabled"}
+	}
+
+	return s.generateRecoveryCodes(ctx, userId)
+}
+
+// Verifying a user recovery code and getting the number of remaining codes.
+func (s *MFAService) VerifyRecoveryCode(ctx context.Context, userId ksuid.KSUID, code string) (int, error) {
+	// Normalizing recovery code entered by the user.
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+
+	// Using a user recovery code.
+	return s.recovery.Use(ctx, userId, hash.Token(code, s.recoveryKey))
+}
+
+// Generating and storing hashed user recovery codes.
+func (s *MFAService) generateRecoveryCodes(ctx context.Context, userId ksuid.KSUID) ([]string, error) {
+	codes := make([]string, s.cfg.RecoveryCodes)
+	hashes := make([]string, s.cfg.RecoveryCodes)
+
+	for i := range codes {
+		// Generating a random recovery code.
+		code, err := rand.GenerateString(recoveryCodeLength)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes[i] = hash.Token(code, s.recoveryKey)
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+	}
+
+	// Replacing all user recovery codes.
+	if err := s.recovery.Replace(ctx, userId, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Validating a TOTP code at the current time and returning the matched time step.
+func (s *MFAService) validate(authenticator domain.TOTP, code string) (uint64, error) {
+	// Decrypting TOTP secret.
+	secret, err := aes.Decrypt(authenticator.Secret, s.cfg.EncryptionKey)
+	if err != nil {
+		return 0, err
+	}
+
+	// Validating TOTP code.
+	step, ok := totp.Validate(string(secret), code, s.clock())
+	if !ok {
+		return 0, errors.New("invalid totp code")
+	}
+
+	return step, nil
+}
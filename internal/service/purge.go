@@ -0,0 +1,101 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/config"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Default deleted users purge interval.
+const defaultPurgeInterval = time.Hour
+
+// Default deleted users grace period.
+const defaultGracePeriod = time.Hour * 720
+
+// Deleted users purge service interface.
+type Purge interface {
+	Purge(ctx context.Context) (int64, error)
+	RunPurge(ctx context.Context)
+}
+
+// Deleted users purge service structure.
+type PurgeService struct {
+	repos       postgres.User
+	interval    time.Duration
+	gracePeriod time.Duration
+}
+
+// Creating a new deleted users purge service.
+func NewPurgeService(repos postgres.User, cfg *config.DeletionConfig) *PurgeService {
+	interval := cfg.PurgeInterval
+
+	// Check if the purge interval is not set.
+	if interval <= 0 {
+		log.Warn().Msgf("Invalid deleted users purge interval, using default %s", defaultPurgeInterval)
+		interval = defaultPurgeInterval
+	}
+
+	// Check if the grace period is not set, it would purge every deleted user.
+	if cfg.GracePeriod <= 0 {
+		log.Warn().Msgf("Invalid deleted users grace period, using default %s", defaultGracePeriod)
+	}
+
+	return &PurgeService{repos: repos, interval: interval, gracePeriod: gracePeriod(cfg)}
+}
+
+// Purging users deleted before the grace period.
+func (s *PurgeService) Purge(ctx context.Context) (int64, error) {
+	return s.repos.Purge(ctx, s.gracePeriod)
+}
+
+// Running deleted users purge every purge interval until the context is done.
+func (s *PurgeService) RunPurge(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		// Purging deleted users.
+		n, err := s.Purge(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to purge deleted users")
+		} else if n > 0 {
+			log.Info().Msgf("Purged %d deleted users", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Getting deleted users grace period, the default is used if it is not set.
+func gracePeriod(cfg *config.DeletionConfig) time.Duration {
+	if cfg.GracePeriod <= 0 {
+		return defaultGracePeriod
+	}
+
+	return cfg.GracePeriod
+}
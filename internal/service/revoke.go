@@ -0,0 +1,87 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/durudex/durudex-user-service/internal/config"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Session revoke service interface.
+type Revoke interface {
+	Session(ctx context.Context, id, userId ksuid.KSUID) error
+	All(ctx context.Context, userId, except ksuid.KSUID) error
+	Family(ctx context.Context, familyId ksuid.KSUID) error
+	IsRevoked(ctx context.Context, sessionId ksuid.KSUID) (bool, error)
+}
+
+// Session revoke service structure.
+type RevokeService struct {
+	session  postgres.Session
+	denylist redis.Denylist
+	cfg      *config.AuthConfig
+}
+
+// Creating a new session revoke service.
+func NewRevokeService(session postgres.Session, denylist redis.Denylist, cfg *config.AuthConfig) *RevokeService {
+	return &RevokeService{session: session, denylist: denylist, cfg: cfg}
+}
+
+// Revoking a user session.
+func (s *RevokeService) Session(ctx context.Context, id, userId ksuid.KSUID) error {
+	// Deleting a user session family.
+	if err := s.session.DeleteByID(ctx, id, userId); err != nil {
+		return err
+	}
+
+	// Denying issued access tokens of the session.
+	return s.denylist.AddSessions(ctx, []ksuid.KSUID{id}, s.cfg.JWT.TTL)
+}
+
+// Revoking all user sessions except one.
+func (s *RevokeService) All(ctx context.Context, userId, except ksuid.KSUID) error {
+	// Deleting all user session families except one.
+	families, err := s.session.DeleteAll(ctx, userId, except)
+	if err != nil {
+		return err
+	}
+
+	// Denying issued access tokens of the sessions.
+	return s.denylist.AddSessions(ctx, families, s.cfg.JWT.TTL)
+}
+
+// Revoking a user session family.
+func (s *RevokeService) Family(ctx context.Context, familyId ksuid.KSUID) error {
+	// Deleting a user session family.
+	if err := s.session.DeleteFamily(ctx, familyId); err != nil {
+		return err
+	}
+
+	// Denying issued access tokens of the session.
+	return s.denylist.AddSessions(ctx, []ksuid.KSUID{familyId}, s.cfg.JWT.TTL)
+}
+
+// Checking if the user session access tokens are revoked.
+func (s *RevokeService) IsRevoked(ctx context.Context, sessionId ksuid.KSUID) (bool, error) {
+	return s.denylist.IsDenied(ctx, sessionId)
+}
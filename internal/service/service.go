@@ -18,32 +18,89 @@
 package service
 
 import (
+	"net/url"
+	"time"
+
 	"github.com/durudex/durudex-user-service/internal/config"
 	"github.com/durudex/durudex-user-service/internal/repository"
 	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
+	"github.com/durudex/durudex-user-service/pkg/webauthn"
+
+	"github.com/rs/zerolog/log"
 )
 
+// Building a link url with the token query parameter.
+func buildLink(base, token string) (string, error) {
+	// Parsing base link url.
+	link, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
+
 // Service structure.
 type Service struct {
 	User
 	Auth
 	Code
+	MFA
+	WebAuthn
+	MagicLink
+	Purge
 }
 
 // Creating a new service.
 func NewService(repos *repository.Repository, config *config.Config, email v1.EmailUserServiceClient) *Service {
-	codeService := NewCodeService(repos.Redis, email, &config.Code)
-	userService := NewUserService(repos.Postgres.User, codeService, &config.Password)
+	codeService := NewCodeService(repos.Redis.Code, email, &config.Code)
+	revokeService := NewRevokeService(repos.Postgres.Session, repos.Redis.Denylist, &config.Auth)
+	attemptService := NewAttemptService(repos.Redis.Attempt, &config.Auth.Attempt)
+	userService := NewUserService(repos.Postgres.User, repos.Postgres.Profile, repos.Redis.EmailRevert,
+		codeService, revokeService, attemptService, email, config)
+	mfaService := NewMFAService(userService, repos.Postgres.TOTP, repos.Postgres.RecoveryCode,
+		&config.Auth.MFA, time.Now)
+
+	// Creating a new jwt key set.
+	keys, err := newKeySet(config.Auth.JWT)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create jwt key set")
+	}
+
+	authService := &AuthService{
+		user:      userService,
+		code:      codeService,
+		email:     email,
+		session:   repos.Postgres.Session,
+		revoke:    revokeService,
+		mfa:       mfaService,
+		attempt:   attemptService,
+		visit:     NewVisitService(repos.Postgres.User, repos.Redis.Visit, &config.User.LastVisit),
+		challenge: repos.Redis.MFA,
+		keys:      keys,
+		cfg:       &config.Auth,
+	}
+
+	// Creating a new WebAuthn relying party.
+	rp := webauthn.NewRelyingParty(config.Auth.WebAuthn.RPID, config.Auth.WebAuthn.RPName,
+		config.Auth.WebAuthn.Origins, config.Auth.WebAuthn.ChallengeTTL)
+	webauthnService := NewWebAuthnService(repos.Postgres.User, repos.Postgres.Credential,
+		repos.Redis.WebAuthn, authService, rp, config.Auth.Session.HashKey)
+
+	magicLinkService := NewMagicLinkService(repos.Postgres.User, repos.Redis.MagicLink, authService,
+		email, &config.Auth)
 
 	return &Service{
-		User: userService,
-		Auth: &AuthService{
-			user:    userService,
-			code:    codeService,
-			email:   email,
-			session: repos.Postgres.Session,
-			cfg:     &config.Auth,
-		},
-		Code: codeService,
+		User:      userService,
+		Auth:      authService,
+		Code:      codeService,
+		MFA:       mfaService,
+		WebAuthn:  webauthnService,
+		MagicLink: magicLinkService,
+		Purge:     NewPurgeService(repos.Postgres.User, &config.User.Deletion),
 	}
 }
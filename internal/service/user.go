@@ -19,12 +19,18 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 
 	"github.com/durudex/durudex-user-service/internal/config"
 	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	"github.com/durudex/durudex-user-service/pkg/auth"
 	"github.com/durudex/durudex-user-service/pkg/hash"
+	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
 
+	"github.com/rs/zerolog/log"
 	"github.com/segmentio/ksuid"
 )
 
@@ -32,21 +38,42 @@ import (
 type User interface {
 	Create(ctx context.Context, user domain.User) (ksuid.KSUID, error)
 	GetByID(ctx context.Context, id ksuid.KSUID) (domain.User, error)
-	GetByCreds(ctx context.Context, username, password string) (domain.User, error)
+	GetByIDs(ctx context.Context, ids []ksuid.KSUID) ([]*domain.User, error)
+	GetByUsername(ctx context.Context, username string, resolveOld bool) (domain.User, error)
+	Search(ctx context.Context, query string, verified *bool, cursor string, limit int) ([]domain.User, string, error)
+	GetByCreds(ctx context.Context, username, password, ip string) (domain.User, error)
+	VerifyCreds(ctx context.Context, username, password, ip string) (domain.User, error)
 	ForgotPassword(ctx context.Context, password, email string, code uint64) error
-	UpdateAvatar(ctx context.Context, id ksuid.KSUID, avatarUrl string) error
+	UpdateAvatar(ctx context.Context, id ksuid.KSUID, avatarUrl *string) error
+	VerifyEmail(ctx context.Context, id ksuid.KSUID, code uint64) error
+	SetVerified(ctx context.Context, id ksuid.KSUID, verified bool) error
+	ChangeEmail(ctx context.Context, id ksuid.KSUID, email string, code uint64, except ksuid.KSUID) error
+	RevertEmail(ctx context.Context, token string) error
+	ChangePassword(ctx context.Context, id ksuid.KSUID, password, newPassword string, revoke bool, except ksuid.KSUID) error
+	UpdateUsername(ctx context.Context, id ksuid.KSUID, username string) error
+	GetProfile(ctx context.Context, id ksuid.KSUID) (domain.Profile, error)
+	UpdateProfile(ctx context.Context, id ksuid.KSUID, profile domain.Profile, fields []string) error
+	SoftDelete(ctx context.Context, id ksuid.KSUID, password string) error
+	Restore(ctx context.Context, id ksuid.KSUID) error
+	Delete(ctx context.Context, id ksuid.KSUID) error
 }
 
 // User service structure.
 type UserService struct {
-	repos postgres.User
-	code  Code
-	cfg   *config.PasswordConfig
+	repos   postgres.User
+	profile postgres.Profile
+	revert  redis.EmailRevert
+	code    Code
+	revoke  Revoke
+	attempt Attempt
+	email   v1.EmailUserServiceClient
+	cfg     *config.Config
 }
 
 // Creating a new user service.
-func NewUserService(repos postgres.User, code Code, cfg *config.PasswordConfig) *UserService {
-	return &UserService{repos: repos, code: code, cfg: cfg}
+func NewUserService(repos postgres.User, profile postgres.Profile, revert redis.EmailRevert, code Code, revoke Revoke, attempt Attempt, email v1.EmailUserServiceClient, cfg *config.Config) *UserService {
+	return &UserService{repos: repos, profile: profile, revert: revert, code: code, revoke: revoke,
+		attempt: attempt, email: email, cfg: cfg}
 }
 
 // Creating a new user.
@@ -65,7 +92,7 @@ func (s *UserService) Create(ctx context.Context, user domain.User) (ksuid.KSUID
 	}
 
 	// Hashing user password.
-	user.Password, err = hash.Hash(user.Password, s.cfg.Cost)
+	user.Password, err = hash.Hash(user.Password, s.cfg.Password.Cost)
 	if err != nil {
 		return ksuid.Nil, err
 	}
@@ -84,21 +111,185 @@ func (s *UserService) GetByID(ctx context.Context, id ksuid.KSUID) (domain.User,
 	user, err := s.repos.GetByID(ctx, id)
 	if err != nil {
 		return domain.User{}, err
+	} else if user.DeletedAt != nil {
+		return domain.User{}, &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
 	}
 
 	return user, nil
 }
 
-// Getting user by credentials.
-func (s *UserService) GetByCreds(ctx context.Context, username, password string) (domain.User, error) {
+// Getting users by ids in the order of ids, users that are not found are nil.
+func (s *UserService) GetByIDs(ctx context.Context, ids []ksuid.KSUID) ([]*domain.User, error) {
+	// Check number of ids.
+	if len(ids) == 0 || len(ids) > s.cfg.User.Lookup.MaxIds {
+		return nil, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Number of Ids"}
+	}
+
+	// Getting users by ids.
+	users, err := s.repos.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[ksuid.KSUID]*domain.User, len(users))
+	for i := range users {
+		found[users[i].Id] = &users[i]
+	}
+
+	// Ordering users by ids.
+	result := make([]*domain.User, len(ids))
+	for i, id := range ids {
+		result[i] = found[id]
+	}
+
+	return result, nil
+}
+
+// Getting user by username, optionally resolving previous usernames.
+func (s *UserService) GetByUsername(ctx context.Context, username string, resolveOld bool) (domain.User, error) {
+	// Getting user by current username.
+	user, err := s.repos.GetByUsername(ctx, username)
+	if err == nil && user.DeletedAt == nil {
+		user.Username = username
+		return user, nil
+	} else if err == nil {
+		err = &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	var e *domain.Error
+
+	// Check if previous usernames should be resolved.
+	if !resolveOld || !errors.As(err, &e) || e.Code != domain.CodeNotFound {
+		return domain.User{}, err
+	}
+
+	// Resolving previous username to its last owner.
+	id, err := s.repos.ResolveUsername(ctx, username)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	// Getting user by id.
+	user, err = s.GetByID(ctx, id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	user.Id = id
+
+	return user, nil
+}
+
+// Searching users by username, newest users first. Returns the cursor of the next page, empty if
+// there are no more users.
+func (s *UserService) Search(ctx context.Context, query string, verified *bool, cursor string, limit int) ([]domain.User, string, error) {
+	// Check search query.
+	if !domain.RxQuery.MatchString(query) {
+		return nil, "", &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Query"}
+	}
+
+	// Check search limit.
+	if limit == 0 {
+		limit = s.cfg.User.Search.DefaultLimit
+	} else if limit < 0 || limit > s.cfg.User.Search.MaxLimit {
+		return nil, "", &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Limit"}
+	}
+
+	// Decoding search cursor.
+	before, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Searching users, one more user is fetched to check if there is a next page.
+	users, err := s.repos.Search(ctx, domain.UserSearch{
+		Query:    query,
+		Verified: verified,
+		Before:   before,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Check if there is a next page.
+	if len(users) <= limit {
+		return users, "", nil
+	}
+
+	users = users[:limit]
+
+	return users, encodeCursor(users[limit-1].Id), nil
+}
+
+// Encoding user id to an opaque search cursor.
+func encodeCursor(id ksuid.KSUID) string {
+	return base64.RawURLEncoding.EncodeToString(id.Bytes())
+}
+
+// Decoding opaque search cursor to a user id, empty cursor is a nil id.
+func decodeCursor(cursor string) (ksuid.KSUID, error) {
+	if cursor == "" {
+		return ksuid.Nil, nil
+	}
+
+	// Decoding cursor bytes.
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ksuid.Nil, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Cursor"}
+	}
+
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(bytes)
+	if err != nil {
+		return ksuid.Nil, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Cursor"}
+	}
+
+	return id, nil
+}
+
+// Getting user by credentials, failed attempts are counted for the username and ip address.
+func (s *UserService) GetByCreds(ctx context.Context, username, password, ip string) (domain.User, error) {
+	// Verifying user credentials.
+	user, err := s.VerifyCreds(ctx, username, password, ip)
+	if err != nil {
+		return domain.User{}, err
+	} else if user.DeletedAt != nil {
+		return domain.User{}, &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return user, nil
+}
+
+// Verifying user credentials, failed attempts are counted for the username and ip address. Soft
+// deleted users are returned, so they can be restored by signing in.
+func (s *UserService) VerifyCreds(ctx context.Context, username, password, ip string) (domain.User, error) {
+	// Check if sign in attempts are not blocked.
+	if err := s.attempt.Check(ctx, username, ip); err != nil {
+		return domain.User{}, err
+	}
+
 	// Getting user by username.
 	user, err := s.repos.GetByUsername(ctx, username)
 	if err != nil {
-		return user, err
+		var e *domain.Error
+
+		// Counting failed sign in attempt with unknown username.
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			if err := s.attempt.Fail(ctx, username, ip); err != nil {
+				return domain.User{}, err
+			}
+		}
+
+		return domain.User{}, err
 	}
 
 	// Checking if user password is correct.
 	if !hash.Check(user.Password, password) {
+		// Counting failed sign in attempt with invalid password.
+		if err := s.attempt.Fail(ctx, username, ip); err != nil {
+			return domain.User{}, err
+		}
+
 		return domain.User{}, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Credentials"}
 	}
 
@@ -108,7 +299,7 @@ func (s *UserService) GetByCreds(ctx context.Context, username, password string)
 // Forgot user password.
 func (s *UserService) ForgotPassword(ctx context.Context, password, email string, code uint64) error {
 	// Verify email code.
-	verify, err := s.code.VerifyEmailCode(ctx, email, code)
+	verify, err := s.code.VerifyEmailCode(ctx, email, domain.CodePurposePasswordReset, code)
 	if err != nil || !verify {
 		return err
 	}
@@ -119,16 +310,298 @@ func (s *UserService) ForgotPassword(ctx context.Context, password, email string
 	}
 
 	// Hashing input user password.
-	hashPassword, err := hash.Hash(password, s.cfg.Cost)
+	hashPassword, err := hash.Hash(password, s.cfg.Password.Cost)
 	if err != nil {
 		return err
 	}
 
 	// Forgot password.
-	return s.repos.ForgotPassword(ctx, hashPassword, email)
+	id, err := s.repos.ForgotPassword(ctx, hashPassword, email)
+	if err != nil || id == ksuid.Nil {
+		return err
+	}
+
+	// Revoking all user sessions after password change.
+	return s.revoke.All(ctx, id, ksuid.Nil)
 }
 
-// Updating user avatar.
-func (s *UserService) UpdateAvatar(ctx context.Context, id ksuid.KSUID, avatarUrl string) error {
+// Updating user avatar, nil avatar url clears the avatar.
+func (s *UserService) UpdateAvatar(ctx context.Context, id ksuid.KSUID, avatarUrl *string) error {
+	// Validate user avatar url.
+	if avatarUrl != nil {
+		if err := domain.ValidateAvatarUrl(*avatarUrl, s.cfg.User.Avatar.Schemes, s.cfg.User.Avatar.Hosts,
+			s.cfg.User.Avatar.MaxLength); err != nil {
+			return err
+		}
+	}
+
 	return s.repos.UpdateAvatar(ctx, avatarUrl, id)
 }
+
+// Verifying user email address.
+func (s *UserService) VerifyEmail(ctx context.Context, id ksuid.KSUID, code uint64) error {
+	// Getting user by id.
+	user, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	} else if user.Verified {
+		return nil
+	}
+
+	// Verify email code.
+	verify, err := s.code.VerifyEmailCode(ctx, user.Email, domain.CodePurposeEmailVerification, code)
+	if err != nil || !verify {
+		return err
+	}
+
+	return s.repos.SetVerified(ctx, id, true)
+}
+
+// Setting user verified status.
+func (s *UserService) SetVerified(ctx context.Context, id ksuid.KSUID, verified bool) error {
+	return s.repos.SetVerified(ctx, id, verified)
+}
+
+// Changing user email address.
+func (s *UserService) ChangeEmail(ctx context.Context, id ksuid.KSUID, email string, code uint64, except ksuid.KSUID) error {
+	// Check user email address.
+	if !domain.RxEmail.MatchString(email) {
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Email"}
+	}
+
+	// Getting user by id.
+	user, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	} else if user.Email == email {
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Email is not changed"}
+	}
+
+	// Verify code sent to the new email address.
+	verify, err := s.code.VerifyEmailCode(ctx, email, domain.CodePurposeEmailChange, code)
+	if err != nil || !verify {
+		return err
+	}
+
+	// Generating a new revert token.
+	token, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	// Building revert link url.
+	link, err := buildLink(s.cfg.User.EmailChange.RevertURL, token)
+	if err != nil {
+		return err
+	}
+
+	// Creating a new revert of the change to the previous email address before the change, so the
+	// change is never applied without a way to revert it.
+	if err := s.revert.Create(ctx, hash.Token(token, s.cfg.Auth.Session.HashKey), domain.EmailRevert{
+		UserId: id,
+		Email:  user.Email,
+	}, s.cfg.User.EmailChange.RevertTTL); err != nil {
+		return err
+	}
+
+	// Updating user email address.
+	if err := s.repos.UpdateEmail(ctx, id, email); err != nil {
+		return err
+	}
+
+	// Revoking other user sessions after email address change.
+	if err := s.revoke.All(ctx, id, except); err != nil {
+		return err
+	}
+
+	// Sending an email to the previous email address with a way to revert the change.
+	if _, err := s.email.SendEmailUserEmailChanged(ctx, &v1.SendEmailUserEmailChangedRequest{
+		Email:    user.Email,
+		NewEmail: email,
+		Link:     link,
+	}); err != nil {
+		// The email address is already changed, so the change must not fail.
+		log.Warn().Err(err).Msg("failed to send email changed email")
+	}
+
+	return nil
+}
+
+// Reverting user email address change.
+func (s *UserService) RevertEmail(ctx context.Context, token string) error {
+	// Consuming a revert, so it can be used only once.
+	revert, err := s.revert.Consume(ctx, hash.Token(token, s.cfg.Auth.Session.HashKey))
+	if err != nil {
+		var e *domain.Error
+
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Revert Token"}
+		}
+
+		return err
+	}
+
+	// Restoring the previous email address.
+	if err := s.repos.UpdateEmail(ctx, revert.UserId, revert.Email); err != nil {
+		return err
+	}
+
+	// Revoking all user sessions, because the change may have been made by someone else.
+	return s.revoke.All(ctx, revert.UserId, ksuid.Nil)
+}
+
+// Changing user password.
+func (s *UserService) ChangePassword(ctx context.Context, id ksuid.KSUID, password, newPassword string, revoke bool, except ksuid.KSUID) error {
+	// Getting user by id.
+	user, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Check if password attempts are not blocked.
+	if err := s.attempt.CheckUser(ctx, user.Username); err != nil {
+		return err
+	}
+
+	// Getting current user password hash.
+	hashPassword, err := s.repos.GetPassword(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Checking if current user password is correct.
+	if !hash.Check(hashPassword, password) {
+		// Counting failed password attempt, shared with sign in attempts.
+		if err := s.attempt.FailUser(ctx, user.Username); err != nil {
+			return err
+		}
+
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Credentials"}
+	}
+
+	// Resetting failed password attempts.
+	if err := s.attempt.Reset(ctx, user.Username); err != nil {
+		return err
+	}
+
+	// Check new user password.
+	if !domain.RxPassword.MatchString(newPassword) {
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Password"}
+	}
+
+	// Hashing new user password.
+	hashPassword, err = hash.Hash(newPassword, s.cfg.Password.Cost)
+	if err != nil {
+		return err
+	}
+
+	// Updating user password.
+	if err := s.repos.UpdatePassword(ctx, id, hashPassword); err != nil {
+		return err
+	}
+
+	// Revoking other user sessions after password change.
+	if revoke {
+		if err := s.revoke.All(ctx, id, except); err != nil {
+			return err
+		}
+	}
+
+	// Sending an email to a user with changed password.
+	if _, err := s.email.SendEmailUserPasswordChanged(ctx, &v1.SendEmailUserPasswordChangedRequest{
+		Email: user.Email,
+	}); err != nil {
+		// The password is already changed, so the change must not fail.
+		log.Warn().Err(err).Msg("failed to send password changed email")
+	}
+
+	return nil
+}
+
+// Updating username.
+func (s *UserService) UpdateUsername(ctx context.Context, id ksuid.KSUID, username string) error {
+	// Check username.
+	if !domain.RxUsername.MatchString(username) {
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Username"}
+	}
+
+	// Updating username and reserving the previous one.
+	return s.repos.UpdateUsername(ctx, id, username, s.cfg.User.Username.Cooldown,
+		s.cfg.User.Username.Reservation)
+}
+
+// Getting user profile.
+func (s *UserService) GetProfile(ctx context.Context, id ksuid.KSUID) (domain.Profile, error) {
+	return s.profile.Get(ctx, id)
+}
+
+// Updating user profile fields, all fields are updated if fields are empty.
+func (s *UserService) UpdateProfile(ctx context.Context, id ksuid.KSUID, profile domain.Profile, fields []string) error {
+	// Validate user profile fields.
+	if err := profile.Validate(fields); err != nil {
+		return err
+	}
+
+	return s.profile.Update(ctx, id, profile, fields)
+}
+
+// Soft deleting user after checking the password, the user can be restored during the grace period.
+func (s *UserService) SoftDelete(ctx context.Context, id ksuid.KSUID, password string) error {
+	// Getting user by id.
+	user, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Check if password attempts are not blocked.
+	if err := s.attempt.CheckUser(ctx, user.Username); err != nil {
+		return err
+	}
+
+	// Getting current user password hash.
+	hashPassword, err := s.repos.GetPassword(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Checking if user password is correct.
+	if !hash.Check(hashPassword, password) {
+		// Counting failed password attempt, shared with sign in attempts.
+		if err := s.attempt.FailUser(ctx, user.Username); err != nil {
+			return err
+		}
+
+		return &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Credentials"}
+	}
+
+	// Resetting failed password attempts.
+	if err := s.attempt.Reset(ctx, user.Username); err != nil {
+		return err
+	}
+
+	// Marking user as deleted.
+	if err := s.repos.SoftDelete(ctx, id); err != nil {
+		return err
+	}
+
+	// Revoking all user sessions after deletion.
+	return s.revoke.All(ctx, id, ksuid.Nil)
+}
+
+// Restoring soft deleted user during the grace period, does nothing if the user is not deleted.
+func (s *UserService) Restore(ctx context.Context, id ksuid.KSUID) error {
+	// Restoring user in a single round trip.
+	active, err := s.repos.Restore(ctx, id, gracePeriod(&s.cfg.User.Deletion))
+	if err != nil {
+		return err
+	} else if !active {
+		return &domain.Error{Code: domain.CodeNotFound, Message: "User not found"}
+	}
+
+	return nil
+}
+
+// Deleting user.
+func (s *UserService) Delete(ctx context.Context, id ksuid.KSUID) error {
+	return s.repos.Delete(ctx, id)
+}
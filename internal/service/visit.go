@@ -0,0 +1,79 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/durudex/durudex-user-service/internal/config"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+
+	"github.com/rs/zerolog/log"
+	"github.com/segmentio/ksuid"
+)
+
+// Default user last visit update interval.
+const defaultVisitInterval = time.Minute * 5
+
+// User last visit service interface.
+type Visit interface {
+	Touch(ctx context.Context, userId ksuid.KSUID) error
+}
+
+// User last visit service structure.
+type VisitService struct {
+	repos    postgres.User
+	debounce redis.Visit
+	interval time.Duration
+}
+
+// Creating a new user last visit service.
+func NewVisitService(repos postgres.User, debounce redis.Visit, cfg *config.LastVisitConfig) *VisitService {
+	interval := cfg.Interval
+
+	// Check if the last visit interval is not set.
+	if interval <= 0 {
+		log.Warn().Msgf("Invalid user last visit interval, using default %s", defaultVisitInterval)
+		interval = defaultVisitInterval
+	}
+
+	return &VisitService{repos: repos, debounce: debounce, interval: interval}
+}
+
+// Updating user last visit at most once per interval.
+func (s *VisitService) Touch(ctx context.Context, userId ksuid.KSUID) error {
+	// Check if user last visit has already been updated in the interval.
+	ok, err := s.debounce.Debounce(ctx, userId, s.interval)
+	if err != nil || !ok {
+		return err
+	}
+
+	// Updating user last visit.
+	if err := s.repos.UpdateLastVisit(ctx, userId); err != nil {
+		// Resetting debounce, so the next visit retries the update.
+		if err := s.debounce.Reset(ctx, userId); err != nil {
+			log.Warn().Err(err).Msg("failed to reset last visit debounce")
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/repository/postgres"
+	"github.com/durudex/durudex-user-service/internal/repository/redis"
+	"github.com/durudex/durudex-user-service/pkg/hash"
+	"github.com/durudex/durudex-user-service/pkg/webauthn"
+
+	"github.com/segmentio/ksuid"
+)
+
+// User WebAuthn service interface.
+type WebAuthn interface {
+	BeginRegistration(ctx context.Context, userId ksuid.KSUID) (webauthn.CreationOptions, error)
+	FinishRegistration(ctx context.Context, userId ksuid.KSUID, clientDataJSON, attestation []byte) ([]byte, error)
+	BeginLogin(ctx context.Context, username string) (webauthn.RequestOptions, error)
+	FinishLogin(ctx context.Context, assertion domain.Assertion, ip string) (domain.Tokens, error)
+}
+
+// Purpose of the key derived for fake credential ids, so the hash key is not reused.
+const fakeCredentialPurpose string = "durudex webauthn fake credential"
+
+// User WebAuthn service structure.
+type WebAuthnService struct {
+	user       postgres.User
+	credential postgres.Credential
+	challenge  redis.WebAuthn
+	auth       Auth
+	rp         *webauthn.RelyingParty
+	fakeKey    string
+}
+
+// Creating a new user WebAuthn service.
+func NewWebAuthnService(user postgres.User, credential postgres.Credential, challenge redis.WebAuthn, auth Auth, rp *webauthn.RelyingParty, hashKey string) *WebAuthnService {
+	return &WebAuthnService{
+		user:       user,
+		credential: credential,
+		challenge:  challenge,
+		auth:       auth,
+		rp:         rp,
+		fakeKey:    hash.DeriveKey(hashKey, fakeCredentialPurpose),
+	}
+}
+
+// Beginning a user WebAuthn credential registration.
+func (s *WebAuthnService) BeginRegistration(ctx context.Context, userId ksuid.KSUID) (webauthn.CreationOptions, error) {
+	// Getting user by id.
+	user, err := s.user.GetByID(ctx, userId)
+	if err != nil {
+		return webauthn.CreationOptions{}, err
+	}
+
+	// Getting already registered user credentials.
+	exclude, err := s.credentialIds(ctx, userId)
+	if err != nil {
+		return webauthn.CreationOptions{}, err
+	}
+
+	// Creating a new registration ceremony challenge.
+	challenge, err := s.createChallenge(ctx, domain.WebAuthnSession{Type: webauthn.TypeCreate, UserId: userId})
+	if err != nil {
+		return webauthn.CreationOptions{}, err
+	}
+
+	return s.rp.CreationOptions(challenge, webauthn.UserEntity{
+		Id:          userId.Bytes(),
+		Name:        user.Username,
+		DisplayName: user.Username,
+	}, exclude), nil
+}
+
+// Finishing a user WebAuthn credential registration.
+func (s *WebAuthnService) FinishRegistration(ctx context.Context, userId ksuid.KSUID, clientDataJSON, attestation []byte) ([]byte, error) {
+	// Parsing collected client data and consuming the ceremony challenge.
+	clientData, session, err := s.consumeChallenge(ctx, clientDataJSON, webauthn.TypeCreate)
+	if err != nil {
+		return nil, err
+	} else if session.UserId != userId {
+		return nil, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Challenge"}
+	}
+
+	// Verifying a registration ceremony.
+	credential, err := s.rp.VerifyRegistration(clientData, attestation)
+	if err != nil {
+		return nil, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Credential"}
+	}
+
+	// Creating a new user WebAuthn credential.
+	if err := s.credential.Create(ctx, domain.Credential{
+		Id:        credential.Id,
+		UserId:    userId,
+		PublicKey: credential.PublicKey,
+		SignCount: credential.SignCount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return credential.Id, nil
+}
+
+// Beginning a user WebAuthn login.
+func (s *WebAuthnService) BeginLogin(ctx context.Context, username string) (webauthn.RequestOptions, error) {
+	var (
+		userId ksuid.KSUID
+		allow  [][]byte
+	)
+
+	// Discoverable credentials do not need the username.
+	if username != "" {
+		// Getting user by username.
+		user, err := s.user.GetByUsername(ctx, username)
+		if err != nil {
+			var e *domain.Error
+
+			if !errors.As(err, &e) || e.Code != domain.CodeNotFound {
+				return webauthn.RequestOptions{}, err
+			}
+
+			// Binding the challenge of an unknown username to a random user, so it cannot be redeemed.
+			user.Id = ksuid.New()
+		} else {
+			// Getting registered user credentials.
+			allow, err = s.credentialIds(ctx, user.Id)
+			if err != nil {
+				return webauthn.RequestOptions{}, err
+			}
+		}
+
+		userId = user.Id
+
+		// Unknown usernames and users without credentials get a stable fake credential, so the
+		// options do not reveal if the username exists.
+		if len(allow) == 0 {
+			allow = [][]byte{s.fakeCredentialId(username)}
+		}
+	}
+
+	// Creating a new login ceremony challenge.
+	challenge, err := s.createChallenge(ctx, domain.WebAuthnSession{Type: webauthn.TypeGet, UserId: userId})
+	if err != nil {
+		return webauthn.RequestOptions{}, err
+	}
+
+	return s.rp.RequestOptions(challenge, allow), nil
+}
+
+// Finishing a user WebAuthn login.
+func (s *WebAuthnService) FinishLogin(ctx context.Context, assertion domain.Assertion, ip string) (domain.Tokens, error) {
+	// Parsing collected client data and consuming the ceremony challenge.
+	clientData, session, err := s.consumeChallenge(ctx, assertion.ClientDataJSON, webauthn.TypeGet)
+	if err != nil {
+		return domain.Tokens{}, err
+	}
+
+	// Getting a user WebAuthn credential.
+	credential, err := s.credential.Get(ctx, assertion.CredentialId)
+	if err != nil {
+		var e *domain.Error
+
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return domain.Tokens{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Credential"}
+		}
+
+		return domain.Tokens{}, err
+	}
+
+	// Check that the credential belongs to the user that began the login.
+	if !session.UserId.IsNil() && session.UserId != credential.UserId {
+		return domain.Tokens{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Credential"}
+	}
+
+	// Verifying an assertion ceremony.
+	signCount, err := s.rp.VerifyAssertion(clientData, assertion.ClientDataJSON,
+		assertion.AuthenticatorData, assertion.Signature, credential.PublicKey)
+	if err != nil {
+		return domain.Tokens{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Credential"}
+	}
+
+	// Updating credential signature counter.
+	if err := s.credential.UpdateSignCount(ctx, credential.Id, signCount); err != nil {
+		return domain.Tokens{}, err
+	}
+
+	// Creating a new user session.
+	return s.auth.CreateSession(ctx, credential.UserId, ip)
+}
+
+// Creating a new WebAuthn ceremony challenge.
+func (s *WebAuthnService) createChallenge(ctx context.Context, session domain.WebAuthnSession) (string, error) {
+	// Generating a new random challenge.
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return "", err
+	}
+
+	// Creating a new ceremony challenge.
+	if err := s.challenge.CreateChallenge(ctx, challenge, session, s.rp.Timeout); err != nil {
+		return "", err
+	}
+
+	return challenge, nil
+}
+
+// Parsing collected client data and consuming the ceremony challenge.
+func (s *WebAuthnService) consumeChallenge(ctx context.Context, clientDataJSON []byte, typ string) (webauthn.ClientData, domain.WebAuthnSession, error) {
+	// Parsing collected client data.
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		return webauthn.ClientData{}, domain.WebAuthnSession{}, &domain.Error{Code: domain.CodeInvalidArgument, Message: "Invalid Client Data"}
+	}
+
+	// Consuming the ceremony challenge.
+	session, err := s.challenge.ConsumeChallenge(ctx, clientData.Challenge)
+	if err != nil {
+		var e *domain.Error
+
+		if errors.As(err, &e) && e.Code == domain.CodeNotFound {
+			return webauthn.ClientData{}, domain.WebAuthnSession{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Challenge"}
+		}
+
+		return webauthn.ClientData{}, domain.WebAuthnSession{}, err
+	} else if session.Type != typ {
+		return webauthn.ClientData{}, domain.WebAuthnSession{}, &domain.Error{Code: domain.CodeUnauthenticated, Message: "Invalid Challenge"}
+	}
+
+	return clientData, session, nil
+}
+
+// Getting a stable fake credential id of the username.
+func (s *WebAuthnService) fakeCredentialId(username string) []byte {
+	// Keyed token hash is always a valid hex string.
+	id, _ := hex.DecodeString(hash.Token(username, s.fakeKey))
+
+	return id
+}
+
+// Getting registered user credential ids.
+func (s *WebAuthnService) credentialIds(ctx context.Context, userId ksuid.KSUID) ([][]byte, error) {
+	// Getting all user WebAuthn credentials.
+	credentials, err := s.credential.GetAll(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([][]byte, len(credentials))
+	for i, credential := range credentials {
+		ids[i] = credential.Id
+	}
+
+	return ids, nil
+}
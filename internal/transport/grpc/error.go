@@ -42,6 +42,18 @@ func errorHandler(err error) error {
 		case domain.CodeInvalidArgument:
 			// Return gRPC error with status code invalid argument.
 			return status.Error(codes.InvalidArgument, e.Message)
+		case domain.CodeUnauthenticated:
+			// Return gRPC error with status code unauthenticated.
+			return status.Error(codes.Unauthenticated, e.Message)
+		case domain.CodeTooManyAttempts:
+			// Return gRPC error with status code resource exhausted.
+			return status.Error(codes.ResourceExhausted, e.Message)
+		case domain.CodeLocked:
+			// Return gRPC error with status code permission denied.
+			return status.Error(codes.PermissionDenied, e.Message)
+		case domain.CodeFailedPrecondition:
+			// Return gRPC error with status code failed precondition.
+			return status.Error(codes.FailedPrecondition, e.Message)
 		case domain.CodeInternal:
 			return status.Error(codes.Internal, "Internal Server Error")
 		}
@@ -20,11 +20,14 @@ package v1
 import (
 	"context"
 
+	"github.com/durudex/dugopb/type/timestamp"
 	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/internal/service"
 	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
 
 	"github.com/segmentio/ksuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // User auth gRPC handler.
@@ -57,12 +60,27 @@ func (h *AuthHandler) UserSignUp(ctx context.Context, input *v1.UserSignUpReques
 // User Sign In gRPC handler.
 func (h *AuthHandler) UserSignIn(ctx context.Context, input *v1.UserSignInRequest) (*v1.UserSignInResponse, error) {
 	// User Sign In.
-	tokens, err := h.service.SignIn(ctx, input.Username, input.Password, input.Ip)
+	result, err := h.service.SignIn(ctx, input.Username, input.Password, input.Ip)
 	if err != nil {
 		return &v1.UserSignInResponse{}, err
 	}
 
-	return &v1.UserSignInResponse{Access: tokens.Access, Refresh: tokens.Refresh}, nil
+	return &v1.UserSignInResponse{
+		Access:   result.Tokens.Access,
+		Refresh:  result.Tokens.Refresh,
+		MfaToken: result.MFAToken,
+	}, nil
+}
+
+// Verifying a user multi-factor authentication challenge gRPC handler.
+func (h *AuthHandler) VerifyMFA(ctx context.Context, input *v1.VerifyMFARequest) (*v1.VerifyMFAResponse, error) {
+	// Verifying a user multi-factor authentication challenge.
+	tokens, err := h.service.VerifyMFA(ctx, input.MfaToken, input.Code, input.Ip)
+	if err != nil {
+		return &v1.VerifyMFAResponse{}, err
+	}
+
+	return &v1.VerifyMFAResponse{Access: tokens.Access, Refresh: tokens.Refresh}, nil
 }
 
 // User Sign Out gRPC handler.
@@ -77,11 +95,128 @@ func (h *AuthHandler) UserSignOut(ctx context.Context, input *v1.UserSignOutRequ
 
 // User Refresh token gRPC handler.
 func (h *AuthHandler) RefreshUserToken(ctx context.Context, input *v1.RefreshUserTokenRequest) (*v1.RefreshUserTokenResponse, error) {
-	// Refresh user token.
-	access, err := h.service.RefreshTokens(ctx, input.Refresh, input.Ip)
+	// Refresh user tokens.
+	tokens, err := h.service.RefreshTokens(ctx, input.Refresh, input.Ip)
 	if err != nil {
 		return &v1.RefreshUserTokenResponse{}, err
 	}
 
-	return &v1.RefreshUserTokenResponse{Access: access}, nil
+	return &v1.RefreshUserTokenResponse{Access: tokens.Access, Refresh: tokens.Refresh}, nil
+}
+
+// Getting a list of user sessions gRPC handler.
+func (h *AuthHandler) ListUserSessions(ctx context.Context, input *v1.ListUserSessionsRequest) (*v1.ListUserSessionsResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.ListUserSessionsResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Getting all active user sessions.
+	sessions, err := h.service.GetSessions(ctx, userId)
+	if err != nil {
+		return &v1.ListUserSessionsResponse{}, err
+	}
+
+	response := make([]*v1.UserSession, len(sessions))
+
+	for i, session := range sessions {
+		response[i] = &v1.UserSession{
+			Id:        session.FamilyId.Bytes(),
+			Ip:        session.Ip,
+			CreatedAt: timestamp.New(session.FamilyId.Time()),
+			ExpiresIn: timestamp.New(session.ExpiresIn),
+		}
+	}
+
+	return &v1.ListUserSessionsResponse{Sessions: response}, nil
+}
+
+// Revoking a user session gRPC handler.
+func (h *AuthHandler) RevokeSession(ctx context.Context, input *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.RevokeSessionResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Getting session id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.RevokeSessionResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Revoking a user session.
+	if err := h.service.RevokeSession(ctx, id, userId); err != nil {
+		return &v1.RevokeSessionResponse{}, err
+	}
+
+	return &v1.RevokeSessionResponse{}, nil
+}
+
+// Revoking all user sessions gRPC handler.
+func (h *AuthHandler) RevokeAllSessions(ctx context.Context, input *v1.RevokeAllSessionsRequest) (*v1.RevokeAllSessionsResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.RevokeAllSessionsResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	except := ksuid.Nil
+
+	// Getting current session id that will not be revoked.
+	if input.Except != nil {
+		except, err = ksuid.FromBytes(input.Except)
+		if err != nil {
+			return &v1.RevokeAllSessionsResponse{}, status.Error(codes.InvalidArgument, "Invalid Except Id")
+		}
+	}
+
+	// Revoking all user sessions.
+	if err := h.service.RevokeAllSessions(ctx, userId, except); err != nil {
+		return &v1.RevokeAllSessionsResponse{}, err
+	}
+
+	return &v1.RevokeAllSessionsResponse{}, nil
+}
+
+// Validating a user access token gRPC handler.
+func (h *AuthHandler) ValidateAccessToken(ctx context.Context, input *v1.ValidateAccessTokenRequest) (*v1.ValidateAccessTokenResponse, error) {
+	// Validating a user access token.
+	token, err := h.service.ValidateAccessToken(ctx, input.Access)
+	if err != nil {
+		return &v1.ValidateAccessTokenResponse{}, err
+	}
+
+	return &v1.ValidateAccessTokenResponse{
+		UserId:    token.UserId.Bytes(),
+		SessionId: token.SessionId.Bytes(),
+		ExpiresAt: timestamp.New(token.ExpiresAt),
+		Scopes:    token.Scopes,
+		Roles:     token.Roles,
+		TokenId:   token.Id.Bytes(),
+	}, nil
+}
+
+// Getting a JSON Web Key Set gRPC handler.
+func (h *AuthHandler) GetJWKS(ctx context.Context, input *v1.GetJWKSRequest) (*v1.GetJWKSResponse, error) {
+	// Getting public jwt keys.
+	jwks := h.service.GetJWKS()
+
+	keys := make([]*v1.JsonWebKey, len(jwks))
+
+	for i, jwk := range jwks {
+		keys[i] = &v1.JsonWebKey{
+			Kty: jwk.Kty,
+			Use: jwk.Use,
+			Alg: jwk.Alg,
+			Kid: jwk.Kid,
+			N:   jwk.N,
+			E:   jwk.E,
+			Crv: jwk.Crv,
+			X:   jwk.X,
+		}
+	}
+
+	return &v1.GetJWKSResponse{Keys: keys}, nil
 }
@@ -20,6 +20,7 @@ package v1
 import (
 	"context"
 
+	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/internal/service"
 	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
 )
@@ -38,7 +39,7 @@ func NewCodeHandler(service service.Code) *CodeHandler {
 // Creating a new user verification email code.
 func (h *CodeHandler) CreateVerifyUserEmailCode(ctx context.Context, input *v1.CreateVerifyUserEmailCodeRequest) (*v1.CreateVerifyUserEmailCodeResponse, error) {
 	// Create a new user verification email code.
-	if err := h.service.CreateVerifyEmailCode(ctx, input.Email); err != nil {
+	if err := h.service.CreateVerifyEmailCode(ctx, input.Email, input.Ip, domain.CodePurpose(input.Purpose)); err != nil {
 		return &v1.CreateVerifyUserEmailCodeResponse{}, err
 	}
 
@@ -48,7 +49,7 @@ func (h *CodeHandler) CreateVerifyUserEmailCode(ctx context.Context, input *v1.C
 // Verifying user email code.
 func (h *CodeHandler) VerifyUserEmailCode(ctx context.Context, input *v1.VerifyUserEmailCodeRequest) (*v1.VerifyUserEmailCodeResponse, error) {
 	// Verifying user email code.
-	status, err := h.service.VerifyEmailCode(ctx, input.Email, input.Code)
+	status, err := h.service.VerifyEmailCode(ctx, input.Email, domain.CodePurpose(input.Purpose), input.Code)
 	if err != nil {
 		return &v1.VerifyUserEmailCodeResponse{}, err
 	}
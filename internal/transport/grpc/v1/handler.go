@@ -40,4 +40,10 @@ func (h *Handler) RegisterHandlers(srv *grpc.Server) {
 	v1.RegisterUserAuthServiceServer(srv, NewAuthHandler(h.service))
 	// Register user code gRPC handler.
 	v1.RegisterUserCodeServiceServer(srv, NewCodeHandler(h.service))
+	// Register user multi-factor authentication gRPC handler.
+	v1.RegisterUserMFAServiceServer(srv, NewMFAHandler(h.service))
+	// Register user WebAuthn gRPC handler.
+	v1.RegisterUserWebAuthnServiceServer(srv, NewWebAuthnHandler(h.service))
+	// Register user magic sign in link gRPC handler.
+	v1.RegisterUserMagicLinkServiceServer(srv, NewMagicLinkHandler(h.service))
 }
@@ -0,0 +1,61 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package v1
+
+import (
+	"context"
+
+	"github.com/durudex/durudex-user-service/internal/service"
+	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
+)
+
+// User magic sign in link gRPC handler.
+type MagicLinkHandler struct {
+	service service.MagicLink
+	v1.UnimplementedUserMagicLinkServiceServer
+}
+
+// Creating a new user magic sign in link gRPC handler.
+func NewMagicLinkHandler(service service.MagicLink) *MagicLinkHandler {
+	return &MagicLinkHandler{service: service}
+}
+
+// Requesting a user magic sign in link gRPC handler.
+func (h *MagicLinkHandler) RequestMagicLink(ctx context.Context, input *v1.RequestMagicLinkRequest) (*v1.RequestMagicLinkResponse, error) {
+	// Requesting a user magic sign in link.
+	if err := h.service.Request(ctx, input.Username, input.Ip); err != nil {
+		return &v1.RequestMagicLinkResponse{}, err
+	}
+
+	return &v1.RequestMagicLinkResponse{}, nil
+}
+
+// Redeeming a user magic sign in link gRPC handler.
+func (h *MagicLinkHandler) RedeemMagicLink(ctx context.Context, input *v1.RedeemMagicLinkRequest) (*v1.RedeemMagicLinkResponse, error) {
+	// Redeeming a user magic sign in link.
+	result, err := h.service.Redeem(ctx, input.Token, input.Ip)
+	if err != nil {
+		return &v1.RedeemMagicLinkResponse{}, err
+	}
+
+	return &v1.RedeemMagicLinkResponse{
+		Access:   result.Tokens.Access,
+		Refresh:  result.Tokens.Refresh,
+		MfaToken: result.MFAToken,
+	}, nil
+}
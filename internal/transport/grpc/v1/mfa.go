@@ -0,0 +1,94 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package v1
+
+import (
+	"context"
+
+	"github.com/durudex/durudex-user-service/internal/service"
+	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
+
+	"github.com/segmentio/ksuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// User multi-factor authentication gRPC handler.
+type MFAHandler struct {
+	service service.MFA
+	v1.UnimplementedUserMFAServiceServer
+}
+
+// Creating a new user multi-factor authentication gRPC handler.
+func NewMFAHandler(service service.MFA) *MFAHandler {
+	return &MFAHandler{service: service}
+}
+
+// Enrolling a user TOTP authenticator gRPC handler.
+func (h *MFAHandler) EnrollTOTP(ctx context.Context, input *v1.EnrollTOTPRequest) (*v1.EnrollTOTPResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.EnrollTOTPResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Enrolling a user TOTP authenticator.
+	enrollment, err := h.service.EnrollTOTP(ctx, userId)
+	if err != nil {
+		return &v1.EnrollTOTPResponse{}, err
+	}
+
+	return &v1.EnrollTOTPResponse{
+		Secret:        enrollment.Secret,
+		Uri:           enrollment.URI,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}, nil
+}
+
+// Confirming a user TOTP authenticator gRPC handler.
+func (h *MFAHandler) ConfirmTOTP(ctx context.Context, input *v1.ConfirmTOTPRequest) (*v1.ConfirmTOTPResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.ConfirmTOTPResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Confirming a user TOTP authenticator.
+	if err := h.service.ConfirmTOTP(ctx, userId, input.Code); err != nil {
+		return &v1.ConfirmTOTPResponse{}, err
+	}
+
+	return &v1.ConfirmTOTPResponse{}, nil
+}
+
+// Regenerating user recovery codes gRPC handler.
+func (h *MFAHandler) RegenerateRecoveryCodes(ctx context.Context, input *v1.RegenerateRecoveryCodesRequest) (*v1.RegenerateRecoveryCodesResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.RegenerateRecoveryCodesResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Regenerating user recovery codes.
+	recoveryCodes, err := h.service.RegenerateRecoveryCodes(ctx, userId)
+	if err != nil {
+		return &v1.RegenerateRecoveryCodesResponse{}, err
+	}
+
+	return &v1.RegenerateRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/durudex/dugopb/type/timestamp"
+	"github.com/durudex/durudex-user-service/internal/domain"
 	"github.com/durudex/durudex-user-service/internal/service"
 	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
 
@@ -62,10 +63,92 @@ func (h *UserHandler) GetUserById(ctx context.Context, input *v1.GetUserByIdRequ
 	}, nil
 }
 
+// Getting user by username.
+func (h *UserHandler) GetUserByUsername(ctx context.Context, input *v1.GetUserByUsernameRequest) (*v1.GetUserByUsernameResponse, error) {
+	// Getting user by username.
+	user, err := h.service.GetByUsername(ctx, input.Username, input.ResolveOld)
+	if err != nil {
+		return &v1.GetUserByUsernameResponse{}, err
+	}
+
+	return &v1.GetUserByUsernameResponse{
+		Id:        user.Id.Bytes(),
+		Username:  user.Username,
+		LastVisit: timestamp.New(user.LastVisit),
+		Verified:  user.Verified,
+		AvatarUrl: user.AvatarUrl,
+	}, nil
+}
+
+// Getting users by ids.
+func (h *UserHandler) GetUsersByIds(ctx context.Context, input *v1.GetUsersByIdsRequest) (*v1.GetUsersByIdsResponse, error) {
+	ids := make([]ksuid.KSUID, len(input.Ids))
+
+	// Getting user ids from bytes.
+	for i, bytes := range input.Ids {
+		id, err := ksuid.FromBytes(bytes)
+		if err != nil {
+			return &v1.GetUsersByIdsResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+		}
+
+		ids[i] = id
+	}
+
+	// Getting users by ids.
+	users, err := h.service.GetByIDs(ctx, ids)
+	if err != nil {
+		return &v1.GetUsersByIdsResponse{}, err
+	}
+
+	response := make([]*v1.UserById, len(users))
+
+	for i, user := range users {
+		// Check if user is not found.
+		if user == nil {
+			response[i] = &v1.UserById{Id: input.Ids[i]}
+			continue
+		}
+
+		response[i] = &v1.UserById{
+			Id:        input.Ids[i],
+			Found:     true,
+			Username:  user.Username,
+			LastVisit: timestamp.New(user.LastVisit),
+			Verified:  user.Verified,
+			AvatarUrl: user.AvatarUrl,
+		}
+	}
+
+	return &v1.GetUsersByIdsResponse{Users: response}, nil
+}
+
+// Searching users by username.
+func (h *UserHandler) SearchUsers(ctx context.Context, input *v1.SearchUsersRequest) (*v1.SearchUsersResponse, error) {
+	// Searching users.
+	users, next, err := h.service.Search(ctx, input.Query, input.Verified, input.Cursor, int(input.Limit))
+	if err != nil {
+		return &v1.SearchUsersResponse{}, err
+	}
+
+	response := make([]*v1.UserSearchResult, len(users))
+
+	for i, user := range users {
+		response[i] = &v1.UserSearchResult{
+			Id:        user.Id.Bytes(),
+			Username:  user.Username,
+			LastVisit: timestamp.New(user.LastVisit),
+			Verified:  user.Verified,
+			AvatarUrl: user.AvatarUrl,
+		}
+	}
+
+	return &v1.SearchUsersResponse{Users: response, NextCursor: next}, nil
+}
+
 // Getting user by credentials.
 func (h *UserHandler) GetUserByCreds(ctx context.Context, input *v1.GetUserByCredsRequest) (*v1.GetUserByCredsResponse, error) {
 	// Getting user by credentials.
-	user, err := h.service.GetByCreds(ctx, input.Username, input.Password)
+	user, err := h.service.GetByCreds(ctx, input.Username, input.Password, input.Ip)
 	if err != nil {
 		return &v1.GetUserByCredsResponse{}, err
 	}
@@ -89,6 +172,190 @@ func (h *UserHandler) ForgotUserPassword(ctx context.Context, input *v1.ForgotUs
 	return &v1.ForgotUserPasswordResponse{}, nil
 }
 
+// Updating user avatar.
 func (h *UserHandler) UpdateUserAvatar(ctx context.Context, input *v1.UpdateUserAvatarRequest) (*v1.UpdateUserAvatarResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.UpdateUserAvatarResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Updating user avatar.
+	if err := h.service.UpdateAvatar(ctx, id, input.AvatarUrl); err != nil {
+		return &v1.UpdateUserAvatarResponse{}, err
+	}
+
 	return &v1.UpdateUserAvatarResponse{}, nil
 }
+
+// Verifying user email address.
+func (h *UserHandler) VerifyUserEmail(ctx context.Context, input *v1.VerifyUserEmailRequest) (*v1.VerifyUserEmailResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.VerifyUserEmailResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Verifying user email address.
+	if err := h.service.VerifyEmail(ctx, id, input.Code); err != nil {
+		return &v1.VerifyUserEmailResponse{}, err
+	}
+
+	return &v1.VerifyUserEmailResponse{}, nil
+}
+
+// Setting user verified status.
+func (h *UserHandler) SetUserVerified(ctx context.Context, input *v1.SetUserVerifiedRequest) (*v1.SetUserVerifiedResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.SetUserVerifiedResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Setting user verified status.
+	if err := h.service.SetVerified(ctx, id, input.Verified); err != nil {
+		return &v1.SetUserVerifiedResponse{}, err
+	}
+
+	return &v1.SetUserVerifiedResponse{}, nil
+}
+
+// Changing user email address.
+func (h *UserHandler) ChangeUserEmail(ctx context.Context, input *v1.ChangeUserEmailRequest) (*v1.ChangeUserEmailResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.ChangeUserEmailResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	except := ksuid.Nil
+
+	// Getting current session id that will not be revoked.
+	if input.SessionId != nil {
+		except, err = ksuid.FromBytes(input.SessionId)
+		if err != nil {
+			return &v1.ChangeUserEmailResponse{}, status.Error(codes.InvalidArgument, "Invalid Session Id")
+		}
+	}
+
+	// Changing user email address.
+	if err := h.service.ChangeEmail(ctx, id, input.Email, input.Code, except); err != nil {
+		return &v1.ChangeUserEmailResponse{}, err
+	}
+
+	return &v1.ChangeUserEmailResponse{}, nil
+}
+
+// Reverting user email address change.
+func (h *UserHandler) RevertUserEmail(ctx context.Context, input *v1.RevertUserEmailRequest) (*v1.RevertUserEmailResponse, error) {
+	// Reverting user email address change.
+	if err := h.service.RevertEmail(ctx, input.Token); err != nil {
+		return &v1.RevertUserEmailResponse{}, err
+	}
+
+	return &v1.RevertUserEmailResponse{}, nil
+}
+
+// Changing user password.
+func (h *UserHandler) ChangeUserPassword(ctx context.Context, input *v1.ChangeUserPasswordRequest) (*v1.ChangeUserPasswordResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.ChangeUserPasswordResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	except := ksuid.Nil
+
+	// Getting current session id that will not be revoked.
+	if input.SessionId != nil {
+		except, err = ksuid.FromBytes(input.SessionId)
+		if err != nil {
+			return &v1.ChangeUserPasswordResponse{}, status.Error(codes.InvalidArgument, "Invalid Session Id")
+		}
+	}
+
+	// Changing user password.
+	if err := h.service.ChangePassword(ctx, id, input.Password, input.NewPassword,
+		input.RevokeSessions, except); err != nil {
+		return &v1.ChangeUserPasswordResponse{}, err
+	}
+
+	return &v1.ChangeUserPasswordResponse{}, nil
+}
+
+// Updating username.
+func (h *UserHandler) UpdateUsername(ctx context.Context, input *v1.UpdateUsernameRequest) (*v1.UpdateUsernameResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.UpdateUsernameResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Updating username.
+	if err := h.service.UpdateUsername(ctx, id, input.Username); err != nil {
+		return &v1.UpdateUsernameResponse{}, err
+	}
+
+	return &v1.UpdateUsernameResponse{}, nil
+}
+
+// Getting user profile.
+func (h *UserHandler) GetUserProfile(ctx context.Context, input *v1.GetUserProfileRequest) (*v1.GetUserProfileResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.GetUserProfileResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Getting user profile.
+	profile, err := h.service.GetProfile(ctx, id)
+	if err != nil {
+		return &v1.GetUserProfileResponse{}, err
+	}
+
+	return &v1.GetUserProfileResponse{Profile: &v1.UserProfile{
+		DisplayName: profile.DisplayName,
+		Bio:         profile.Bio,
+		Locale:      profile.Locale,
+		Timezone:    profile.Timezone,
+		Links:       profile.Links,
+	}}, nil
+}
+
+// Updating user profile, fields in the update mask not set in the profile are cleared.
+func (h *UserHandler) UpdateUserProfile(ctx context.Context, input *v1.UpdateUserProfileRequest) (*v1.UpdateUserProfileResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.UpdateUserProfileResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Updating user profile, field mask paths are the profile field names.
+	if err := h.service.UpdateProfile(ctx, id, domain.Profile{
+		DisplayName: input.Profile.GetDisplayName(),
+		Bio:         input.Profile.GetBio(),
+		Locale:      input.Profile.GetLocale(),
+		Timezone:    input.Profile.GetTimezone(),
+		Links:       input.Profile.GetLinks(),
+	}, input.UpdateMask.GetPaths()); err != nil {
+		return &v1.UpdateUserProfileResponse{}, err
+	}
+
+	return &v1.UpdateUserProfileResponse{}, nil
+}
+
+// Deleting user.
+func (h *UserHandler) DeleteUser(ctx context.Context, input *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
+	// Getting user id from bytes.
+	id, err := ksuid.FromBytes(input.Id)
+	if err != nil {
+		return &v1.DeleteUserResponse{}, status.Error(codes.InvalidArgument, "Invalid Id")
+	}
+
+	// Soft deleting user.
+	if err := h.service.SoftDelete(ctx, id, input.Password); err != nil {
+		return &v1.DeleteUserResponse{}, err
+	}
+
+	return &v1.DeleteUserResponse{}, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/durudex/durudex-user-service/internal/domain"
+	"github.com/durudex/durudex-user-service/internal/service"
+	v1 "github.com/durudex/durudex-user-service/pkg/pb/durudex/v1"
+
+	"github.com/segmentio/ksuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// User WebAuthn gRPC handler.
+type WebAuthnHandler struct {
+	service service.WebAuthn
+	v1.UnimplementedUserWebAuthnServiceServer
+}
+
+// Creating a new user WebAuthn gRPC handler.
+func NewWebAuthnHandler(service service.WebAuthn) *WebAuthnHandler {
+	return &WebAuthnHandler{service: service}
+}
+
+// Beginning a user WebAuthn credential registration gRPC handler.
+func (h *WebAuthnHandler) BeginWebAuthnRegistration(ctx context.Context, input *v1.BeginWebAuthnRegistrationRequest) (*v1.BeginWebAuthnRegistrationResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.BeginWebAuthnRegistrationResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Beginning a user WebAuthn credential registration.
+	options, err := h.service.BeginRegistration(ctx, userId)
+	if err != nil {
+		return &v1.BeginWebAuthnRegistrationResponse{}, err
+	}
+
+	// Encoding credential creation options.
+	data, err := json.Marshal(options)
+	if err != nil {
+		return &v1.BeginWebAuthnRegistrationResponse{}, err
+	}
+
+	return &v1.BeginWebAuthnRegistrationResponse{Options: string(data)}, nil
+}
+
+// Finishing a user WebAuthn credential registration gRPC handler.
+func (h *WebAuthnHandler) FinishWebAuthnRegistration(ctx context.Context, input *v1.FinishWebAuthnRegistrationRequest) (*v1.FinishWebAuthnRegistrationResponse, error) {
+	// Getting user id from bytes.
+	userId, err := ksuid.FromBytes(input.UserId)
+	if err != nil {
+		return &v1.FinishWebAuthnRegistrationResponse{}, status.Error(codes.InvalidArgument, "Invalid User Id")
+	}
+
+	// Finishing a user WebAuthn credential registration.
+	id, err := h.service.FinishRegistration(ctx, userId, input.ClientDataJson, input.AttestationObject)
+	if err != nil {
+		return &v1.FinishWebAuthnRegistrationResponse{}, err
+	}
+
+	return &v1.FinishWebAuthnRegistrationResponse{CredentialId: id}, nil
+}
+
+// Beginning a user WebAuthn login gRPC handler.
+func (h *WebAuthnHandler) BeginWebAuthnLogin(ctx context.Context, input *v1.BeginWebAuthnLoginRequest) (*v1.BeginWebAuthnLoginResponse, error) {
+	// Beginning a user WebAuthn login.
+	options, err := h.service.BeginLogin(ctx, input.Username)
+	if err != nil {
+		return &v1.BeginWebAuthnLoginResponse{}, err
+	}
+
+	// Encoding credential request options.
+	data, err := json.Marshal(options)
+	if err != nil {
+		return &v1.BeginWebAuthnLoginResponse{}, err
+	}
+
+	return &v1.BeginWebAuthnLoginResponse{Options: string(data)}, nil
+}
+
+// Finishing a user WebAuthn login gRPC handler.
+func (h *WebAuthnHandler) FinishWebAuthnLogin(ctx context.Context, input *v1.FinishWebAuthnLoginRequest) (*v1.FinishWebAuthnLoginResponse, error) {
+	// Finishing a user WebAuthn login.
+	tokens, err := h.service.FinishLogin(ctx, domain.Assertion{
+		CredentialId:      input.CredentialId,
+		ClientDataJSON:    input.ClientDataJson,
+		AuthenticatorData: input.AuthenticatorData,
+		Signature:         input.Signature,
+	}, input.Ip)
+	if err != nil {
+		return &v1.FinishWebAuthnLoginResponse{}, err
+	}
+
+	return &v1.FinishWebAuthnLoginResponse{Access: tokens.Access, Refresh: tokens.Refresh}, nil
+}
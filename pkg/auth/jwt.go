@@ -19,7 +19,10 @@ package auth
 
 import (
 	"crypto/rand"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -27,19 +30,91 @@ import (
 
 // JWT manager interface.
 type JWT interface {
-	GenerateAccessToken(subject, signingKey string, ttl time.Duration) (string, error)
+	GenerateAccessToken(claims Claims, key Key, ttl time.Duration) (string, error)
+	ParseAccessToken(token string, keys *KeySet) (*Claims, error)
 	GenerateRefreshToken() (string, error)
 }
 
+// JWT access token claims structure.
+type Claims struct {
+	jwt.StandardClaims
+	SessionId string   `json:"sid,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	Scopes    Scopes   `json:"scope,omitempty"`
+}
+
+// JWT access token scopes, encoded as a space-delimited string claim (RFC 8693).
+type Scopes []string
+
+// Encoding scopes as a space-delimited string.
+func (s Scopes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.Join(s, " "))
+}
+
+// Decoding scopes from a space-delimited string.
+func (s *Scopes) UnmarshalJSON(data []byte) error {
+	var scope string
+
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return err
+	}
+
+	*s = strings.Fields(scope)
+
+	return nil
+}
+
 // Generating a new jwt access token.
-func GenerateAccessToken(subject, signingKey string, ttl time.Duration) (string, error) {
+func GenerateAccessToken(claims Claims, key Key, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(ttl).Unix()
+
 	// Generating a new jwt token with claims.
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
-		ExpiresAt: time.Now().Add(ttl).Unix(),
-		Subject:   subject,
+	token := jwt.NewWithClaims(key.Method, claims)
+
+	// Set signing key id header.
+	token.Header["kid"] = key.Id
+
+	return token.SignedString(key.PrivateKey)
+}
+
+// Parsing and validating a jwt access token.
+func ParseAccessToken(token string, keys *KeySet) (*Claims, error) {
+	var claims Claims
+
+	// Parsing jwt token with claims.
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		// Getting signing key id header.
+		kid, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("missing jwt key id")
+		}
+
+		// Getting a signing key by id.
+		key, ok := keys.Get(kid, time.Now())
+		if !ok {
+			return nil, fmt.Errorf("unknown jwt key id: %s", kid)
+		}
+
+		// Check that the token is signed with the key algorithm.
+		if t.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected jwt signing method: %s", t.Method.Alg())
+		}
+
+		return key.PublicKey, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the token has an expiration time.
+	if claims.ExpiresAt == 0 {
+		return nil, errors.New("missing jwt expiration time")
+	}
 
-	return token.SignedString([]byte(signingKey))
+	return &claims, nil
 }
 
 // Generating a new refresh token.
@@ -18,19 +18,31 @@
 package auth_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/durudex/durudex-user-service/pkg/auth"
+
+	"github.com/golang-jwt/jwt"
 )
 
 // Testing generating a new jwt access token.
 func Test_GenerateAccessToken(t *testing.T) {
 	// Testing args.
 	type args struct {
-		subject    string
-		signingKey string
-		ttl        time.Duration
+		claims auth.Claims
+		key    auth.Key
+		ttl    time.Duration
+	}
+
+	// Generating a new ed25519 key.
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating ed25519 key: %s", err.Error())
 	}
 
 	// Tests structures.
@@ -42,9 +54,26 @@ func Test_GenerateAccessToken(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				subject:    "1",
-				signingKey: "secret-key",
-				ttl:        time.Hour * 9999,
+				claims: auth.Claims{StandardClaims: jwt.StandardClaims{Subject: "1"}},
+				key: auth.Key{
+					Id:         "default",
+					Method:     jwt.SigningMethodHS256,
+					PrivateKey: []byte("secret-key"),
+				},
+				ttl: time.Hour * 9999,
+			},
+		},
+		{
+			name: "EdDSA",
+			args: args{
+				claims: auth.Claims{StandardClaims: jwt.StandardClaims{Subject: "1"}},
+				key: auth.Key{
+					Id:         "ed25519",
+					Method:     jwt.SigningMethodEdDSA,
+					PrivateKey: privateKey,
+					PublicKey:  publicKey,
+				},
+				ttl: time.Hour * 9999,
 			},
 		},
 	}
@@ -53,7 +82,7 @@ func Test_GenerateAccessToken(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Generate a new jwt access token.
-			got, err := auth.GenerateAccessToken(tt.args.subject, tt.args.signingKey, tt.args.ttl)
+			got, err := auth.GenerateAccessToken(tt.args.claims, tt.args.key, tt.args.ttl)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("error generating access token: %s", err.Error())
 			}
@@ -66,6 +95,133 @@ func Test_GenerateAccessToken(t *testing.T) {
 	}
 }
 
+// Testing parsing a jwt access token.
+func Test_ParseAccessToken(t *testing.T) {
+	// Generating a new ed25519 key.
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating ed25519 key: %s", err.Error())
+	}
+
+	edKey := auth.Key{
+		Id:         "ed25519",
+		Method:     jwt.SigningMethodEdDSA,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}
+	hsKey := auth.Key{
+		Id:         "ed25519",
+		Method:     jwt.SigningMethodHS256,
+		PrivateKey: []byte("secret-key"),
+		PublicKey:  []byte("secret-key"),
+	}
+
+	// Creating a new jwt key set.
+	keys, err := auth.NewKeySet(edKey)
+	if err != nil {
+		t.Fatalf("error creating jwt key set: %s", err.Error())
+	}
+
+	claims := auth.Claims{
+		StandardClaims: jwt.StandardClaims{
+			Id:       "3",
+			Issuer:   "user.service.durudex.local",
+			Audience: "durudex",
+			Subject:  "1",
+		},
+		SessionId: "2",
+		Roles:     []string{"user"},
+		Scopes:    []string{"user", "user:verify"},
+	}
+
+	// Generating a new signed jwt token.
+	generate := func(key auth.Key, ttl time.Duration) string {
+		token, err := auth.GenerateAccessToken(claims, key, ttl)
+		if err != nil {
+			t.Fatalf("error generating access token: %s", err.Error())
+		}
+
+		return token
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "OK", token: generate(edKey, time.Hour)},
+		{name: "Expired", token: generate(edKey, -time.Hour), wantErr: true},
+		{name: "Malformed", token: "qwerty", wantErr: true},
+		{name: "Unexpected Signing Method", token: generate(hsKey, time.Hour), wantErr: true},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Parsing a jwt access token.
+			got, err := auth.ParseAccessToken(tt.token, keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error parsing access token: %v", err)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			// Check for similarity of claims.
+			if got.IssuedAt == 0 || got.ExpiresAt == 0 {
+				t.Error("error issued at and expires at claims are not set")
+			}
+			got.IssuedAt, got.ExpiresAt = 0, 0
+
+			if !reflect.DeepEqual(*got, claims) {
+				t.Error("error claims are not similar")
+			}
+		})
+	}
+}
+
+// Testing encoding and decoding jwt access token scopes.
+func Test_Scopes(t *testing.T) {
+	// Tests structures.
+	tests := []struct {
+		name   string
+		scopes auth.Scopes
+		want   string
+	}{
+		{name: "Single", scopes: auth.Scopes{"user"}, want: `"user"`},
+		{name: "Multiple", scopes: auth.Scopes{"user", "user:verify"}, want: `"user user:verify"`},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Encoding scopes.
+			data, err := json.Marshal(tt.scopes)
+			if err != nil {
+				t.Fatalf("error encoding scopes: %s", err.Error())
+			}
+
+			// Check that scopes are encoded as a space-delimited string.
+			if string(data) != tt.want {
+				t.Errorf("error encoded scopes: got %s, want %s", data, tt.want)
+			}
+
+			// Decoding scopes.
+			var got auth.Scopes
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("error decoding scopes: %s", err.Error())
+			}
+
+			// Check for similarity of scopes.
+			if !reflect.DeepEqual(got, tt.scopes) {
+				t.Errorf("error scopes are not similar: %v", got)
+			}
+		})
+	}
+}
+
 // Testing generating a new refresh token.
 func Test_GenerateRefreshToken(t *testing.T) {
 	// Tests structures.
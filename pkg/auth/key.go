@@ -0,0 +1,208 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// JWT signing algorithms.
+const (
+	AlgorithmHS256 string = "HS256"
+	AlgorithmRS256 string = "RS256"
+	AlgorithmEdDSA string = "EdDSA"
+)
+
+// JWT signing key structure.
+type Key struct {
+	Id         string
+	Method     jwt.SigningMethod
+	PrivateKey interface{}
+	PublicKey  interface{}
+	ActiveFrom time.Time
+	// Time after which the key no longer signs tokens.
+	ExpiresAt time.Time
+	// Time after which tokens signed with the key are no longer verified, expires at if not set.
+	VerifyUntil time.Time
+}
+
+// Creating a new jwt signing key.
+func NewKey(id, algorithm string, key []byte, activeFrom, expiresAt time.Time) (Key, error) {
+	k := Key{Id: id, ActiveFrom: activeFrom, ExpiresAt: expiresAt}
+
+	switch algorithm {
+	case AlgorithmHS256:
+		k.Method, k.PrivateKey, k.PublicKey = jwt.SigningMethodHS256, key, key
+	case AlgorithmRS256:
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(key)
+		if err != nil {
+			return Key{}, err
+		}
+
+		k.Method, k.PrivateKey, k.PublicKey = jwt.SigningMethodRS256, privateKey, &privateKey.PublicKey
+	case AlgorithmEdDSA:
+		privateKey, err := jwt.ParseEdPrivateKeyFromPEM(key)
+		if err != nil {
+			return Key{}, err
+		}
+
+		k.Method, k.PrivateKey = jwt.SigningMethodEdDSA, privateKey
+		k.PublicKey = privateKey.(ed25519.PrivateKey).Public()
+	default:
+		return Key{}, fmt.Errorf("unsupported jwt signing algorithm: %s", algorithm)
+	}
+
+	return k, nil
+}
+
+// Check if the key is active at the specified time.
+func (k Key) Active(now time.Time) bool {
+	return !k.ActiveFrom.After(now) && !k.Expired(now)
+}
+
+// Check if the key is expired at the specified time.
+func (k Key) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !k.ExpiresAt.After(now)
+}
+
+// Check if tokens signed with the key can be verified at the specified time.
+func (k Key) Verifiable(now time.Time) bool {
+	until := k.VerifyUntil
+	if until.IsZero() {
+		until = k.ExpiresAt
+	}
+
+	return until.IsZero() || until.After(now)
+}
+
+// JSON Web Key structure.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// Getting a public JSON Web Key. Symmetric keys have no public part.
+func (k Key) JWK() (JWK, bool) {
+	switch publicKey := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: k.Method.Alg(),
+			Kid: k.Id,
+			N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Use: "sig",
+			Alg: k.Method.Alg(),
+			Kid: k.Id,
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(publicKey),
+		}, true
+	}
+
+	return JWK{}, false
+}
+
+// JWT key set structure.
+type KeySet struct{ keys []Key }
+
+// Creating a new jwt key set.
+func NewKeySet(keys ...Key) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("jwt key set is empty")
+	}
+
+	ids := make(map[string]struct{}, len(keys))
+
+	// Check for duplicate key ids.
+	for _, key := range keys {
+		if _, ok := ids[key.Id]; ok {
+			return nil, fmt.Errorf("duplicate jwt key id: %s", key.Id)
+		}
+
+		ids[key.Id] = struct{}{}
+	}
+
+	sorted := make([]Key, len(keys))
+	copy(sorted, keys)
+
+	// Sorting keys from the newest to the oldest.
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActiveFrom.After(sorted[j].ActiveFrom)
+	})
+
+	return &KeySet{keys: sorted}, nil
+}
+
+// Getting the newest active key for signing tokens.
+func (s *KeySet) Signing(now time.Time) (Key, error) {
+	for _, key := range s.keys {
+		if key.Active(now) {
+			return key, nil
+		}
+	}
+
+	return Key{}, errors.New("no active jwt signing key")
+}
+
+// Getting a key that can verify tokens by id.
+func (s *KeySet) Get(id string, now time.Time) (Key, bool) {
+	for _, key := range s.keys {
+		if key.Id == id && key.Verifiable(now) {
+			return key, true
+		}
+	}
+
+	return Key{}, false
+}
+
+// Getting public keys that are active, scheduled for rotation or still verifying tokens.
+func (s *KeySet) JWKS(now time.Time) []JWK {
+	jwks := make([]JWK, 0, len(s.keys))
+
+	for _, key := range s.keys {
+		if !key.Verifiable(now) {
+			continue
+		}
+
+		if jwk, ok := key.JWK(); ok {
+			jwks = append(jwks, jwk)
+		}
+	}
+
+	return jwks
+}
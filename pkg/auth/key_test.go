@@ -0,0 +1,260 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package auth_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/pkg/auth"
+)
+
+// Encoding a private key to PEM.
+func encodePrivateKey(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("error marshaling private key: %s", err.Error())
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+// Testing creating a new jwt signing key.
+func Test_NewKey(t *testing.T) {
+	// Generating a new rsa key.
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating rsa key: %s", err.Error())
+	}
+
+	// Generating a new ed25519 key.
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating ed25519 key: %s", err.Error())
+	}
+
+	// Testing args.
+	type args struct {
+		algorithm string
+		key       []byte
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		wantJWK bool
+		wantErr bool
+	}{
+		{
+			name: "HS256",
+			args: args{algorithm: auth.AlgorithmHS256, key: []byte("secret-key")},
+		},
+		{
+			name:    "RS256",
+			args:    args{algorithm: auth.AlgorithmRS256, key: encodePrivateKey(t, rsaKey)},
+			wantJWK: true,
+		},
+		{
+			name:    "EdDSA",
+			args:    args{algorithm: auth.AlgorithmEdDSA, key: encodePrivateKey(t, edKey)},
+			wantJWK: true,
+		},
+		{
+			name:    "Invalid Key",
+			args:    args{algorithm: auth.AlgorithmRS256, key: []byte("secret-key")},
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported Algorithm",
+			args:    args{algorithm: "none", key: []byte("secret-key")},
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Creating a new jwt signing key.
+			got, err := auth.NewKey("1", tt.args.algorithm, tt.args.key, time.Time{}, time.Time{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error creating jwt signing key: %v", err)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			// Getting a public JSON Web Key.
+			jwk, ok := got.JWK()
+			if ok != tt.wantJWK {
+				t.Errorf("error public key existence are not similar")
+			}
+
+			// Check JSON Web Key algorithm.
+			if ok && jwk.Alg != tt.args.algorithm {
+				t.Errorf("error jwk algorithm are not similar: %s", jwk.Alg)
+			}
+		})
+	}
+}
+
+// Testing getting the jwt signing key.
+func TestKeySet_Signing(t *testing.T) {
+	now := time.Now()
+
+	// Testing args.
+	type args struct{ keys []auth.Key }
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			args: args{keys: []auth.Key{
+				{Id: "old", ActiveFrom: now.Add(-time.Hour * 48)},
+				{Id: "current", ActiveFrom: now.Add(-time.Hour)},
+				{Id: "next", ActiveFrom: now.Add(time.Hour)},
+			}},
+			want: "current",
+		},
+		{
+			name: "Expired",
+			args: args{keys: []auth.Key{
+				{Id: "old", ActiveFrom: now.Add(-time.Hour * 48)},
+				{Id: "expired", ActiveFrom: now.Add(-time.Hour), ExpiresAt: now},
+			}},
+			want: "old",
+		},
+		{
+			name: "No Active Key",
+			args: args{keys: []auth.Key{
+				{Id: "next", ActiveFrom: now.Add(time.Hour)},
+			}},
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Creating a new jwt key set.
+			keys, err := auth.NewKeySet(tt.args.keys...)
+			if err != nil {
+				t.Fatalf("error creating jwt key set: %s", err.Error())
+			}
+
+			// Getting the jwt signing key.
+			got, err := keys.Signing(now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error getting jwt signing key: %v", err)
+			}
+
+			// Check for similarity of key id.
+			if got.Id != tt.want {
+				t.Errorf("error key id are not similar: %s", got.Id)
+			}
+		})
+	}
+}
+
+// Testing getting a jwt key that can verify tokens by id.
+func TestKeySet_Get(t *testing.T) {
+	now := time.Now()
+
+	// Creating a new jwt key set.
+	keys, err := auth.NewKeySet(
+		auth.Key{Id: "current", ActiveFrom: now.Add(-time.Hour)},
+		auth.Key{Id: "expired", ActiveFrom: now.Add(-time.Hour * 48), ExpiresAt: now.Add(-time.Hour)},
+		auth.Key{
+			Id:          "verifying",
+			ActiveFrom:  now.Add(-time.Hour * 48),
+			ExpiresAt:   now.Add(-time.Minute),
+			VerifyUntil: now.Add(time.Minute * 14),
+		},
+		auth.Key{
+			Id:          "retired",
+			ActiveFrom:  now.Add(-time.Hour * 96),
+			ExpiresAt:   now.Add(-time.Hour * 48),
+			VerifyUntil: now.Add(-time.Hour * 47),
+		},
+	)
+	if err != nil {
+		t.Fatalf("error creating jwt key set: %s", err.Error())
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{name: "Active", id: "current", want: true},
+		{name: "Expired", id: "expired", want: false},
+		{name: "Expired But Verifying", id: "verifying", want: true},
+		{name: "Verification Over", id: "retired", want: false},
+		{name: "Unknown", id: "unknown", want: false},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Getting a jwt key by id.
+			_, got := keys.Get(tt.id, now)
+
+			// Check if the key was found.
+			if got != tt.want {
+				t.Errorf("error key found = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Testing creating a new jwt key set with duplicate key ids.
+func Test_NewKeySet(t *testing.T) {
+	// Tests structures.
+	tests := []struct {
+		name    string
+		keys    []auth.Key
+		wantErr bool
+	}{
+		{name: "OK", keys: []auth.Key{{Id: "1"}, {Id: "2"}}},
+		{name: "Empty", wantErr: true},
+		{name: "Duplicate Id", keys: []auth.Key{{Id: "1"}, {Id: "1"}}, wantErr: true},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Creating a new jwt key set.
+			_, err := auth.NewKeySet(tt.keys...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error creating jwt key set: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package aes_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/durudex/durudex-user-service/pkg/crypto/aes"
+)
+
+// Testing encrypting and decrypting data.
+func Test_EncryptDecrypt(t *testing.T) {
+	// Testing args.
+	type args struct {
+		data            []byte
+		key, decryptKey string
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			args: args{data: []byte("qwerty"), key: "secret-key", decryptKey: "secret-key"},
+		},
+		{
+			name:    "Invalid Key",
+			args:    args{data: []byte("qwerty"), key: "secret-key", decryptKey: "another-key"},
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Encrypting data.
+			ciphertext, err := aes.Encrypt(tt.args.data, tt.args.key)
+			if err != nil {
+				t.Fatalf("error encrypting data: %s", err.Error())
+			}
+
+			// Decrypting data.
+			got, err := aes.Decrypt(ciphertext, tt.args.decryptKey)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error decrypting data: %v", err)
+			}
+
+			// Check for similarity of data.
+			if !tt.wantErr && !bytes.Equal(got, tt.args.data) {
+				t.Error("error data are not similar")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package rand_test
+
+import (
+	"testing"
+
+	"github.com/durudex/durudex-user-service/pkg/crypto/rand"
+)
+
+// Testing generating a random string.
+func Test_GenerateString(t *testing.T) {
+	// Testing args.
+	type args struct{ length int }
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			args: args{length: 10},
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Generating a random string.
+			got, err := rand.GenerateString(tt.args.length)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error generating string: %s", err.Error())
+			}
+
+			// Check string length.
+			if len(got) != tt.args.length {
+				t.Errorf("error invalid string length: %d", len(got))
+			}
+		})
+	}
+}
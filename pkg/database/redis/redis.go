@@ -26,6 +26,26 @@ import (
 // Redis driver interface.
 type Redis redis.Cmdable
 
+// Redis pipeline interface.
+type Pipeliner = redis.Pipeliner
+
+// Redis command results.
+type (
+	StringCmd          = redis.StringCmd
+	IntCmd             = redis.IntCmd
+	DurationCmd        = redis.DurationCmd
+	StringStringMapCmd = redis.StringStringMapCmd
+)
+
+// Redis lua script.
+type Script = redis.Script
+
+// Redis nil reply returned when key does not exist.
+const Nil = redis.Nil
+
+// Creating a new redis lua script.
+func NewScript(src string) *Script { return redis.NewScript(src) }
+
 // Creating a new redis client.
 func NewClient(url string) (Redis, error) {
 	// Parsing redis url.
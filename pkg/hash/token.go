@@ -0,0 +1,48 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package hash
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Length of a derived key in bytes.
+const derivedKeyLength int = 32
+
+// Generating a new keyed token hash.
+func Token(token, key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(token))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Deriving a separate key for the purpose from the secret key with HKDF-SHA256.
+func DeriveKey(key, purpose string) string {
+	derived := make([]byte, derivedKeyLength)
+
+	// Reading a single SHA-256 sized key from HKDF cannot fail.
+	_, _ = io.ReadFull(hkdf.New(sha256.New, []byte(key), nil, []byte(purpose)), derived)
+
+	return hex.EncodeToString(derived)
+}
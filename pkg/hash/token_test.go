@@ -0,0 +1,91 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package hash_test
+
+import (
+	"testing"
+
+	"github.com/durudex/durudex-user-service/pkg/hash"
+)
+
+// Testing generating a new keyed token hash.
+func Test_Token(t *testing.T) {
+	// Testing args.
+	type args struct{ token, key string }
+
+	// Tests structures.
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "OK",
+			args: args{token: "qwerty", key: "secret-key"},
+			want: "429ca0e1a46f3de361e05a6a255756e0571d86cc4f753043e26dcd7777916636",
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hash.Token(tt.args.token, tt.args.key)
+
+			// Check token hash.
+			if got != tt.want {
+				t.Errorf("error token hash are not similar: %s", got)
+			}
+		})
+	}
+}
+
+// Testing deriving a separate key for the purpose.
+func Test_DeriveKey(t *testing.T) {
+	// Testing args.
+	type args struct{ key, purpose string }
+
+	// Tests structures.
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "OK",
+			args: args{key: "secret-key", purpose: "recovery-code"},
+			want: "cc513adffc2e978cd4e2e1d43ad0478f950217e21b982fcfdd7f458c4e02a3be",
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hash.DeriveKey(tt.args.key, tt.args.purpose)
+
+			// Check derived key.
+			if got != tt.want {
+				t.Errorf("error derived key are not similar: %s", got)
+			}
+
+			// Check that the derived key differs from the secret key and other purposes.
+			if got == tt.args.key || got == hash.DeriveKey(tt.args.key, "other") {
+				t.Errorf("error derived key is not separated: %s", got)
+			}
+		})
+	}
+}
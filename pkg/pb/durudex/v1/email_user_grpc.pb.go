@@ -24,6 +24,14 @@ type EmailUserServiceClient interface {
 	SendEmailUserLoggedIn(ctx context.Context, in *SendEmailUserLoggedInRequest, opts ...grpc.CallOption) (*SendEmailUserLoggedInResponse, error)
 	// Sending an email to a user with register.
 	SendEmailUserRegister(ctx context.Context, in *SendEmailUserRegisterRequest, opts ...grpc.CallOption) (*SendEmailUserRegisterResponse, error)
+	// Sending an email to a user with all recovery codes used.
+	SendEmailUserRecoveryCodesUsed(ctx context.Context, in *SendEmailUserRecoveryCodesUsedRequest, opts ...grpc.CallOption) (*SendEmailUserRecoveryCodesUsedResponse, error)
+	// Sending an email to a user with a magic sign in link.
+	SendEmailUserMagicLink(ctx context.Context, in *SendEmailUserMagicLinkRequest, opts ...grpc.CallOption) (*SendEmailUserMagicLinkResponse, error)
+	// Sending an email to a user with changed email address.
+	SendEmailUserEmailChanged(ctx context.Context, in *SendEmailUserEmailChangedRequest, opts ...grpc.CallOption) (*SendEmailUserEmailChangedResponse, error)
+	// Sending an email to a user with changed password.
+	SendEmailUserPasswordChanged(ctx context.Context, in *SendEmailUserPasswordChangedRequest, opts ...grpc.CallOption) (*SendEmailUserPasswordChangedResponse, error)
 }
 
 type emailUserServiceClient struct {
@@ -61,6 +69,42 @@ func (c *emailUserServiceClient) SendEmailUserRegister(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *emailUserServiceClient) SendEmailUserRecoveryCodesUsed(ctx context.Context, in *SendEmailUserRecoveryCodesUsedRequest, opts ...grpc.CallOption) (*SendEmailUserRecoveryCodesUsedResponse, error) {
+	out := new(SendEmailUserRecoveryCodesUsedResponse)
+	err := c.cc.Invoke(ctx, "/durudex.v1.EmailUserService/SendEmailUserRecoveryCodesUsed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailUserServiceClient) SendEmailUserMagicLink(ctx context.Context, in *SendEmailUserMagicLinkRequest, opts ...grpc.CallOption) (*SendEmailUserMagicLinkResponse, error) {
+	out := new(SendEmailUserMagicLinkResponse)
+	err := c.cc.Invoke(ctx, "/durudex.v1.EmailUserService/SendEmailUserMagicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailUserServiceClient) SendEmailUserEmailChanged(ctx context.Context, in *SendEmailUserEmailChangedRequest, opts ...grpc.CallOption) (*SendEmailUserEmailChangedResponse, error) {
+	out := new(SendEmailUserEmailChangedResponse)
+	err := c.cc.Invoke(ctx, "/durudex.v1.EmailUserService/SendEmailUserEmailChanged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailUserServiceClient) SendEmailUserPasswordChanged(ctx context.Context, in *SendEmailUserPasswordChangedRequest, opts ...grpc.CallOption) (*SendEmailUserPasswordChangedResponse, error) {
+	out := new(SendEmailUserPasswordChangedResponse)
+	err := c.cc.Invoke(ctx, "/durudex.v1.EmailUserService/SendEmailUserPasswordChanged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmailUserServiceServer is the server API for EmailUserService service.
 // All implementations must embed UnimplementedEmailUserServiceServer
 // for forward compatibility
@@ -71,6 +115,14 @@ type EmailUserServiceServer interface {
 	SendEmailUserLoggedIn(context.Context, *SendEmailUserLoggedInRequest) (*SendEmailUserLoggedInResponse, error)
 	// Sending an email to a user with register.
 	SendEmailUserRegister(context.Context, *SendEmailUserRegisterRequest) (*SendEmailUserRegisterResponse, error)
+	// Sending an email to a user with all recovery codes used.
+	SendEmailUserRecoveryCodesUsed(context.Context, *SendEmailUserRecoveryCodesUsedRequest) (*SendEmailUserRecoveryCodesUsedResponse, error)
+	// Sending an email to a user with a magic sign in link.
+	SendEmailUserMagicLink(context.Context, *SendEmailUserMagicLinkRequest) (*SendEmailUserMagicLinkResponse, error)
+	// Sending an email to a user with changed email address.
+	SendEmailUserEmailChanged(context.Context, *SendEmailUserEmailChangedRequest) (*SendEmailUserEmailChangedResponse, error)
+	// Sending an email to a user with changed password.
+	SendEmailUserPasswordChanged(context.Context, *SendEmailUserPasswordChangedRequest) (*SendEmailUserPasswordChangedResponse, error)
 	mustEmbedUnimplementedEmailUserServiceServer()
 }
 
@@ -87,6 +139,18 @@ func (UnimplementedEmailUserServiceServer) SendEmailUserLoggedIn(context.Context
 func (UnimplementedEmailUserServiceServer) SendEmailUserRegister(context.Context, *SendEmailUserRegisterRequest) (*SendEmailUserRegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEmailUserRegister not implemented")
 }
+func (UnimplementedEmailUserServiceServer) SendEmailUserRecoveryCodesUsed(context.Context, *SendEmailUserRecoveryCodesUsedRequest) (*SendEmailUserRecoveryCodesUsedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailUserRecoveryCodesUsed not implemented")
+}
+func (UnimplementedEmailUserServiceServer) SendEmailUserMagicLink(context.Context, *SendEmailUserMagicLinkRequest) (*SendEmailUserMagicLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailUserMagicLink not implemented")
+}
+func (UnimplementedEmailUserServiceServer) SendEmailUserEmailChanged(context.Context, *SendEmailUserEmailChangedRequest) (*SendEmailUserEmailChangedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailUserEmailChanged not implemented")
+}
+func (UnimplementedEmailUserServiceServer) SendEmailUserPasswordChanged(context.Context, *SendEmailUserPasswordChangedRequest) (*SendEmailUserPasswordChangedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailUserPasswordChanged not implemented")
+}
 func (UnimplementedEmailUserServiceServer) mustEmbedUnimplementedEmailUserServiceServer() {}
 
 // UnsafeEmailUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -154,6 +218,78 @@ func _EmailUserService_SendEmailUserRegister_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmailUserService_SendEmailUserRecoveryCodesUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailUserRecoveryCodesUsedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailUserServiceServer).SendEmailUserRecoveryCodesUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/durudex.v1.EmailUserService/SendEmailUserRecoveryCodesUsed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailUserServiceServer).SendEmailUserRecoveryCodesUsed(ctx, req.(*SendEmailUserRecoveryCodesUsedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailUserService_SendEmailUserMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailUserMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailUserServiceServer).SendEmailUserMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/durudex.v1.EmailUserService/SendEmailUserMagicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailUserServiceServer).SendEmailUserMagicLink(ctx, req.(*SendEmailUserMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailUserService_SendEmailUserEmailChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailUserEmailChangedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailUserServiceServer).SendEmailUserEmailChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/durudex.v1.EmailUserService/SendEmailUserEmailChanged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailUserServiceServer).SendEmailUserEmailChanged(ctx, req.(*SendEmailUserEmailChangedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailUserService_SendEmailUserPasswordChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailUserPasswordChangedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailUserServiceServer).SendEmailUserPasswordChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/durudex.v1.EmailUserService/SendEmailUserPasswordChanged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailUserServiceServer).SendEmailUserPasswordChanged(ctx, req.(*SendEmailUserPasswordChangedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EmailUserService_ServiceDesc is the grpc.ServiceDesc for EmailUserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -173,6 +309,22 @@ var EmailUserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEmailUserRegister",
 			Handler:    _EmailUserService_SendEmailUserRegister_Handler,
 		},
+		{
+			MethodName: "SendEmailUserRecoveryCodesUsed",
+			Handler:    _EmailUserService_SendEmailUserRecoveryCodesUsed_Handler,
+		},
+		{
+			MethodName: "SendEmailUserMagicLink",
+			Handler:    _EmailUserService_SendEmailUserMagicLink_Handler,
+		},
+		{
+			MethodName: "SendEmailUserEmailChanged",
+			Handler:    _EmailUserService_SendEmailUserEmailChanged_Handler,
+		},
+		{
+			MethodName: "SendEmailUserPasswordChanged",
+			Handler:    _EmailUserService_SendEmailUserPasswordChanged_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "durudex/v1/email_user.proto",
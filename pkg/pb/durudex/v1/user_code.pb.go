@@ -25,6 +25,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// User verification code purpose.
+type UserCodePurpose int32
+
+const (
+	// Unspecified purpose, rejected by the service.
+	UserCodePurpose_USER_CODE_PURPOSE_UNSPECIFIED UserCodePurpose = 0
+	// User sign up.
+	UserCodePurpose_USER_CODE_PURPOSE_SIGN_UP UserCodePurpose = 1
+	// User password reset.
+	UserCodePurpose_USER_CODE_PURPOSE_PASSWORD_RESET UserCodePurpose = 2
+	// User email change.
+	UserCodePurpose_USER_CODE_PURPOSE_EMAIL_CHANGE UserCodePurpose = 3
+	// User two-factor authentication.
+	UserCodePurpose_USER_CODE_PURPOSE_MFA UserCodePurpose = 4
+	// User email address verification.
+	UserCodePurpose_USER_CODE_PURPOSE_EMAIL_VERIFICATION UserCodePurpose = 5
+)
+
+// Enum value maps for UserCodePurpose.
+var (
+	UserCodePurpose_name = map[int32]string{
+		0: "USER_CODE_PURPOSE_UNSPECIFIED",
+		1: "USER_CODE_PURPOSE_SIGN_UP",
+		2: "USER_CODE_PURPOSE_PASSWORD_RESET",
+		3: "USER_CODE_PURPOSE_EMAIL_CHANGE",
+		4: "USER_CODE_PURPOSE_MFA",
+		5: "USER_CODE_PURPOSE_EMAIL_VERIFICATION",
+	}
+	UserCodePurpose_value = map[string]int32{
+		"USER_CODE_PURPOSE_UNSPECIFIED":        0,
+		"USER_CODE_PURPOSE_SIGN_UP":            1,
+		"USER_CODE_PURPOSE_PASSWORD_RESET":     2,
+		"USER_CODE_PURPOSE_EMAIL_CHANGE":       3,
+		"USER_CODE_PURPOSE_MFA":                4,
+		"USER_CODE_PURPOSE_EMAIL_VERIFICATION": 5,
+	}
+)
+
+func (x UserCodePurpose) Enum() *UserCodePurpose {
+	p := new(UserCodePurpose)
+	*p = x
+	return p
+}
+
+func (x UserCodePurpose) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserCodePurpose) Descriptor() protoreflect.EnumDescriptor {
+	return file_durudex_v1_user_code_proto_enumTypes[0].Descriptor()
+}
+
+func (UserCodePurpose) Type() protoreflect.EnumType {
+	return &file_durudex_v1_user_code_proto_enumTypes[0]
+}
+
+func (x UserCodePurpose) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserCodePurpose.Descriptor instead.
+func (UserCodePurpose) EnumDescriptor() ([]byte, []int) {
+	return file_durudex_v1_user_code_proto_rawDescGZIP(), []int{0}
+}
+
 // Request for creating a new user verification code.
 type CreateVerifyUserEmailCodeRequest struct {
 	state         protoimpl.MessageState
@@ -33,6 +98,10 @@ type CreateVerifyUserEmailCodeRequest struct {
 
 	// User email address.
 	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// User ip address.
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Verification code purpose.
+	Purpose UserCodePurpose `protobuf:"varint,3,opt,name=purpose,proto3,enum=durudex.v1.UserCodePurpose" json:"purpose,omitempty"`
 }
 
 func (x *CreateVerifyUserEmailCodeRequest) Reset() {
@@ -74,6 +143,20 @@ func (x *CreateVerifyUserEmailCodeRequest) GetEmail() string {
 	return ""
 }
 
+func (x *CreateVerifyUserEmailCodeRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CreateVerifyUserEmailCodeRequest) GetPurpose() UserCodePurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return UserCodePurpose_USER_CODE_PURPOSE_UNSPECIFIED
+}
+
 // Response for creating a new user verification code.
 type CreateVerifyUserEmailCodeResponse struct {
 	state         protoimpl.MessageState
@@ -123,6 +206,8 @@ type VerifyUserEmailCodeRequest struct {
 	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	// User verification code.
 	Code uint64 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Verification code purpose.
+	Purpose UserCodePurpose `protobuf:"varint,3,opt,name=purpose,proto3,enum=durudex.v1.UserCodePurpose" json:"purpose,omitempty"`
 }
 
 func (x *VerifyUserEmailCodeRequest) Reset() {
@@ -171,6 +256,13 @@ func (x *VerifyUserEmailCodeRequest) GetCode() uint64 {
 	return 0
 }
 
+func (x *VerifyUserEmailCodeRequest) GetPurpose() UserCodePurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return UserCodePurpose_USER_CODE_PURPOSE_UNSPECIFIED
+}
+
 // Response for verifying a user email code.
 type VerifyUserEmailCodeResponse struct {
 	state         protoimpl.MessageState
@@ -225,48 +317,70 @@ var File_durudex_v1_user_code_proto protoreflect.FileDescriptor
 var file_durudex_v1_user_code_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x75,
-	0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x22, 0x38, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61,
+	0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x22, 0x7f, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
 	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x22, 0x23, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69,
+	0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x70, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d,
+	0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x72,
+	0x70, 0x6f, 0x73, 0x65, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x35, 0x0a,
+	0x1b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2a, 0xe2, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45,
+	0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x5f,
+	0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x02,
+	0x12, 0x22, 0x0a, 0x1e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55,
+	0x52, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x4d, 0x46, 0x41, 0x10, 0x04, 0x12,
+	0x28, 0x0a, 0x24, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x52,
+	0x50, 0x4f, 0x53, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x32, 0xf3, 0x01, 0x0a, 0x0f, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x64, 0x75, 0x72,
+	0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64,
+	0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69,
 	0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
-	0x35, 0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xf3, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x19, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65,
-	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x75,
-	0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb0, 0x01, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x42,
-	0x0d, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x75, 0x72,
-	0x75, 0x64, 0x65, 0x78, 0x2f, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2d, 0x75, 0x73, 0x65,
-	0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
-	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x3b, 0x64,
-	0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02,
-	0x0a, 0x44, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x44, 0x75,
-	0x72, 0x75, 0x64, 0x65, 0x78, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x44, 0x75, 0x72, 0x75, 0x64,
-	0x65, 0x78, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0b, 0x44, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0xb0, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e,
+	0x76, 0x31, 0x42, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2f, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2d,
+	0x75, 0x73, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2f, 0x76,
+	0x31, 0x3b, 0x64, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58,
+	0x58, 0xaa, 0x02, 0x0a, 0x44, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0a, 0x44, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x44, 0x75,
+	0x72, 0x75, 0x64, 0x65, 0x78, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x44, 0x75, 0x72, 0x75, 0x64, 0x65, 0x78, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -281,23 +395,27 @@ func file_durudex_v1_user_code_proto_rawDescGZIP() []byte {
 	return file_durudex_v1_user_code_proto_rawDescData
 }
 
+var file_durudex_v1_user_code_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_durudex_v1_user_code_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_durudex_v1_user_code_proto_goTypes = []interface{}{
-	(*CreateVerifyUserEmailCodeRequest)(nil),  // 0: durudex.v1.CreateVerifyUserEmailCodeRequest
-	(*CreateVerifyUserEmailCodeResponse)(nil), // 1: durudex.v1.CreateVerifyUserEmailCodeResponse
-	(*VerifyUserEmailCodeRequest)(nil),        // 2: durudex.v1.VerifyUserEmailCodeRequest
-	(*VerifyUserEmailCodeResponse)(nil),       // 3: durudex.v1.VerifyUserEmailCodeResponse
+	(UserCodePurpose)(0),                      // 0: durudex.v1.UserCodePurpose
+	(*CreateVerifyUserEmailCodeRequest)(nil),  // 1: durudex.v1.CreateVerifyUserEmailCodeRequest
+	(*CreateVerifyUserEmailCodeResponse)(nil), // 2: durudex.v1.CreateVerifyUserEmailCodeResponse
+	(*VerifyUserEmailCodeRequest)(nil),        // 3: durudex.v1.VerifyUserEmailCodeRequest
+	(*VerifyUserEmailCodeResponse)(nil),       // 4: durudex.v1.VerifyUserEmailCodeResponse
 }
 var file_durudex_v1_user_code_proto_depIdxs = []int32{
-	0, // 0: durudex.v1.UserCodeService.CreateVerifyUserEmailCode:input_type -> durudex.v1.CreateVerifyUserEmailCodeRequest
-	2, // 1: durudex.v1.UserCodeService.VerifyUserEmailCode:input_type -> durudex.v1.VerifyUserEmailCodeRequest
-	1, // 2: durudex.v1.UserCodeService.CreateVerifyUserEmailCode:output_type -> durudex.v1.CreateVerifyUserEmailCodeResponse
-	3, // 3: durudex.v1.UserCodeService.VerifyUserEmailCode:output_type -> durudex.v1.VerifyUserEmailCodeResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: durudex.v1.CreateVerifyUserEmailCodeRequest.purpose:type_name -> durudex.v1.UserCodePurpose
+	0, // 1: durudex.v1.VerifyUserEmailCodeRequest.purpose:type_name -> durudex.v1.UserCodePurpose
+	1, // 2: durudex.v1.UserCodeService.CreateVerifyUserEmailCode:input_type -> durudex.v1.CreateVerifyUserEmailCodeRequest
+	3, // 3: durudex.v1.UserCodeService.VerifyUserEmailCode:input_type -> durudex.v1.VerifyUserEmailCodeRequest
+	2, // 4: durudex.v1.UserCodeService.CreateVerifyUserEmailCode:output_type -> durudex.v1.CreateVerifyUserEmailCodeResponse
+	4, // 5: durudex.v1.UserCodeService.VerifyUserEmailCode:output_type -> durudex.v1.VerifyUserEmailCodeResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_durudex_v1_user_code_proto_init() }
@@ -360,13 +478,14 @@ func file_durudex_v1_user_code_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_durudex_v1_user_code_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_durudex_v1_user_code_proto_goTypes,
 		DependencyIndexes: file_durudex_v1_user_code_proto_depIdxs,
+		EnumInfos:         file_durudex_v1_user_code_proto_enumTypes,
 		MessageInfos:      file_durudex_v1_user_code_proto_msgTypes,
 	}.Build()
 	File_durudex_v1_user_code_proto = out.File
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package durudexv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UserMagicLinkServiceClient is the client API for UserMagicLinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserMagicLinkServiceClient interface {
+	// Requesting a user magic sign in link.
+	RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*RequestMagicLinkResponse, error)
+	// Redeeming a user magic sign in link.
+	RedeemMagicLink(ctx context.Context, in *RedeemMagicLinkRequest, opts ...grpc.CallOption) (*RedeemMagicLinkResponse, error)
+}
+
+type userMagicLinkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserMagicLinkServiceClient(cc grpc.ClientConnInterface) UserMagicLinkServiceClient {
+	return &userMagicLinkServiceClient{cc}
+}
+
+func (c *userMagicLinkServiceClient) RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*RequestMagicLinkResponse, error) {
+	out := new(RequestMagicLinkResponse)
+	err := c.cc.Invoke(ctx, "/durudex.v1.UserMagicLinkService/RequestMagicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userMagicLinkServiceClient) RedeemMagicLink(ctx context.Context, in *RedeemMagicLinkRequest, opts ...grpc.CallOption) (*RedeemMagicLinkResponse, error) {
+	out := new(RedeemMagicLinkResponse)
+	err := c.cc.Invoke(ctx, "/durudex.v1.UserMagicLinkService/RedeemMagicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserMagicLinkServiceServer is the server API for UserMagicLinkService service.
+// All implementations must embed UnimplementedUserMagicLinkServiceServer
+// for forward compatibility
+type UserMagicLinkServiceServer interface {
+	// Requesting a user magic sign in link.
+	RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*RequestMagicLinkResponse, error)
+	// Redeeming a user magic sign in link.
+	RedeemMagicLink(context.Context, *RedeemMagicLinkRequest) (*RedeemMagicLinkResponse, error)
+	mustEmbedUnimplementedUserMagicLinkServiceServer()
+}
+
+// UnimplementedUserMagicLinkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserMagicLinkServiceServer struct {
+}
+
+func (UnimplementedUserMagicLinkServiceServer) RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*RequestMagicLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestMagicLink not implemented")
+}
+func (UnimplementedUserMagicLinkServiceServer) RedeemMagicLink(context.Context, *RedeemMagicLinkRequest) (*RedeemMagicLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemMagicLink not implemented")
+}
+func (UnimplementedUserMagicLinkServiceServer) mustEmbedUnimplementedUserMagicLinkServiceServer() {}
+
+// UnsafeUserMagicLinkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserMagicLinkServiceServer will
+// result in compilation errors.
+type UnsafeUserMagicLinkServiceServer interface {
+	mustEmbedUnimplementedUserMagicLinkServiceServer()
+}
+
+func RegisterUserMagicLinkServiceServer(s grpc.ServiceRegistrar, srv UserMagicLinkServiceServer) {
+	s.RegisterService(&UserMagicLinkService_ServiceDesc, srv)
+}
+
+func _UserMagicLinkService_RequestMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserMagicLinkServiceServer).RequestMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/durudex.v1.UserMagicLinkService/RequestMagicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserMagicLinkServiceServer).RequestMagicLink(ctx, req.(*RequestMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserMagicLinkService_RedeemMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserMagicLinkServiceServer).RedeemMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/durudex.v1.UserMagicLinkService/RedeemMagicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserMagicLinkServiceServer).RedeemMagicLink(ctx, req.(*RedeemMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserMagicLinkService_ServiceDesc is the grpc.ServiceDesc for UserMagicLinkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserMagicLinkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "durudex.v1.UserMagicLinkService",
+	HandlerType: (*UserMagicLinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestMagicLink",
+			Handler:    _UserMagicLinkService_RequestMagicLink_Handler,
+		},
+		{
+			MethodName: "RedeemMagicLink",
+			Handler:    _UserMagicLinkService_RedeemMagicLink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "durudex/v1/user_magic_link.proto",
+}
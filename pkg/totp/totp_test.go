@@ -0,0 +1,106 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package totp_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/pkg/totp"
+)
+
+// RFC 6238 test secret "12345678901234567890" encoded in base32.
+const testSecret string = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// Testing generating a TOTP code.
+func Test_Generate(t *testing.T) {
+	// Tests structures.
+	tests := []struct {
+		name string
+		time time.Time
+		want string
+	}{
+		{name: "59", time: time.Unix(59, 0), want: "287082"},
+		{name: "1111111109", time: time.Unix(1111111109, 0), want: "081804"},
+		{name: "1234567890", time: time.Unix(1234567890, 0), want: "005924"},
+		{name: "2000000000", time: time.Unix(2000000000, 0), want: "279037"},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Generating a TOTP code.
+			got, err := totp.Generate(testSecret, totp.Step(tt.time))
+			if err != nil {
+				t.Fatalf("error generating code: %s", err.Error())
+			}
+
+			// Check for similarity of code.
+			if got != tt.want {
+				t.Errorf("error code are not similar: %s != %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// Testing validating a TOTP code.
+func Test_Validate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+
+	// Tests structures.
+	tests := []struct {
+		name     string
+		code     string
+		time     time.Time
+		wantStep uint64
+		want     bool
+	}{
+		{name: "OK", code: "005924", time: now, wantStep: totp.Step(now), want: true},
+		{name: "Previous Step", code: "005924", time: now.Add(totp.Period), wantStep: totp.Step(now), want: true},
+		{name: "Expired", code: "005924", time: now.Add(3 * totp.Period)},
+		{name: "Invalid Code", code: "000000", time: now},
+		{name: "Invalid Length", code: "5924", time: now},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Validating a TOTP code.
+			step, ok := totp.Validate(testSecret, tt.code, tt.time)
+			if ok != tt.want || step != tt.wantStep {
+				t.Errorf("error validating code: got %d %t", step, ok)
+			}
+		})
+	}
+}
+
+// Testing generating a secret and provisioning uri.
+func Test_URI(t *testing.T) {
+	// Generating a new secret.
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("error generating secret: %s", err.Error())
+	}
+
+	uri := totp.URI(secret, "Durudex", "example")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/Durudex:example?") || !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("error invalid uri: %s", uri)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// COSE algorithm identifiers.
+const (
+	AlgorithmES256 int64 = -7
+	AlgorithmEdDSA int64 = -8
+	AlgorithmRS256 int64 = -257
+)
+
+// COSE key types and curves.
+const (
+	keyTypeOKP   int64 = 1
+	keyTypeEC2   int64 = 2
+	keyTypeRSA   int64 = 3
+	curveP256    int64 = 1
+	curveEd25519 int64 = 6
+)
+
+// Errors returned while verifying COSE keys.
+var (
+	ErrUnsupportedKey = errors.New("unsupported public key")
+	ErrSignature      = errors.New("invalid signature")
+)
+
+// COSE encoded public key structure.
+type coseKey struct {
+	KeyType   int64  `cbor:"1,keyasint"`
+	Algorithm int64  `cbor:"3,keyasint"`
+	Curve     int64  `cbor:"-1,keyasint,omitempty"`
+	X         []byte `cbor:"-2,keyasint,omitempty"`
+	Y         []byte `cbor:"-3,keyasint,omitempty"`
+}
+
+// COSE encoded RSA public key structure.
+type coseRSAKey struct {
+	KeyType   int64  `cbor:"1,keyasint"`
+	Algorithm int64  `cbor:"3,keyasint"`
+	N         []byte `cbor:"-1,keyasint"`
+	E         []byte `cbor:"-2,keyasint"`
+}
+
+// Parsing a COSE encoded public key.
+func ParsePublicKey(key []byte) (crypto.PublicKey, error) {
+	// Decoding public key type and algorithm.
+	var k coseKey
+	if err := cbor.Unmarshal(key, &k); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case k.KeyType == keyTypeEC2 && k.Algorithm == AlgorithmES256 && k.Curve == curveP256:
+		pub := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(k.X),
+			Y:     new(big.Int).SetBytes(k.Y),
+		}
+
+		// Check that the point is on the curve.
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, ErrUnsupportedKey
+		}
+
+		return pub, nil
+	case k.KeyType == keyTypeOKP && k.Algorithm == AlgorithmEdDSA && k.Curve == curveEd25519:
+		if len(k.X) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKey
+		}
+
+		return ed25519.PublicKey(k.X), nil
+	case k.KeyType == keyTypeRSA && k.Algorithm == AlgorithmRS256:
+		// Decoding RSA public key parameters.
+		var r coseRSAKey
+		if err := cbor.Unmarshal(key, &r); err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(r.N),
+			E: int(new(big.Int).SetBytes(r.E).Int64()),
+		}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// Verifying a signature with a COSE encoded public key.
+func VerifySignature(key, data, signature []byte) error {
+	// Parsing a COSE encoded public key.
+	pub, err := ParsePublicKey(key)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, sum[:], signature) {
+			return ErrSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(pub, data, signature) {
+			return ErrSignature
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, sum[:], signature); err != nil {
+			return ErrSignature
+		}
+	}
+
+	return nil
+}
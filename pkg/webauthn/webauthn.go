@@ -0,0 +1,355 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Client data ceremony types.
+const (
+	TypeCreate string = "webauthn.create"
+	TypeGet    string = "webauthn.get"
+)
+
+// Authenticator data flags.
+const (
+	flagUserPresent      byte = 0x01
+	flagUserVerified     byte = 0x04
+	flagAttestedCredData byte = 0x40
+)
+
+// Length of a generated challenge in bytes.
+const challengeLength int = 32
+
+// Errors returned while verifying ceremonies.
+var (
+	ErrClientData        = errors.New("invalid client data")
+	ErrAuthenticatorData = errors.New("invalid authenticator data")
+	ErrAttestation       = errors.New("unsupported attestation")
+)
+
+// Base64 url encoding without padding used by WebAuthn.
+var encoding = base64.RawURLEncoding
+
+// Relying party structure.
+type RelyingParty struct {
+	Id      string
+	Name    string
+	Origins []string
+	Timeout time.Duration
+}
+
+// Creating a new relying party.
+func NewRelyingParty(id, name string, origins []string, timeout time.Duration) *RelyingParty {
+	return &RelyingParty{Id: id, Name: name, Origins: origins, Timeout: timeout}
+}
+
+// Base64 url encoded bytes.
+type URLEncodedBytes []byte
+
+// Marshal bytes as base64 url encoded string.
+func (b URLEncodedBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encoding.EncodeToString(b))
+}
+
+// Public key credential descriptor structure.
+type CredentialDescriptor struct {
+	Type string          `json:"type"`
+	Id   URLEncodedBytes `json:"id"`
+}
+
+// Public key credential parameters structure.
+type CredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int64  `json:"alg"`
+}
+
+// Relying party entity structure.
+type RelyingPartyEntity struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// User entity structure.
+type UserEntity struct {
+	Id          URLEncodedBytes `json:"id"`
+	Name        string          `json:"name"`
+	DisplayName string          `json:"displayName"`
+}
+
+// Authenticator selection criteria structure.
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// Public key credential creation options structure.
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RelyingParty           RelyingPartyEntity     `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	Parameters             []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	Attestation            string                 `json:"attestation"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+}
+
+// Public key credential request options structure.
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RelyingPartyId   string                 `json:"rpId"`
+	Timeout          int64                  `json:"timeout"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// Collected client data structure.
+type ClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Registered credential structure.
+type Credential struct {
+	Id        []byte
+	PublicKey []byte
+	SignCount uint32
+}
+
+// Attestation object structure.
+type attestationObject struct {
+	Format   string          `cbor:"fmt"`
+	AuthData []byte          `cbor:"authData"`
+	Stmt     cbor.RawMessage `cbor:"attStmt"`
+}
+
+// Parsed authenticator data structure.
+type authenticatorData struct {
+	rpIdHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialId []byte
+	publicKey    []byte
+}
+
+// Generating a new random challenge.
+func NewChallenge() (string, error) {
+	b := make([]byte, challengeLength)
+
+	// Reading random bytes.
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+// Getting credential creation options.
+func (rp *RelyingParty) CreationOptions(challenge string, user UserEntity, exclude [][]byte) CreationOptions {
+	return CreationOptions{
+		Challenge:    challenge,
+		RelyingParty: RelyingPartyEntity{Id: rp.Id, Name: rp.Name},
+		User:         user,
+		Parameters: []CredentialParameter{
+			{Type: "public-key", Algorithm: AlgorithmES256},
+			{Type: "public-key", Algorithm: AlgorithmEdDSA},
+			{Type: "public-key", Algorithm: AlgorithmRS256},
+		},
+		Timeout:            rp.Timeout.Milliseconds(),
+		Attestation:        "none",
+		ExcludeCredentials: descriptors(exclude),
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: "required",
+		},
+	}
+}
+
+// Getting credential request options.
+func (rp *RelyingParty) RequestOptions(challenge string, allow [][]byte) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		RelyingPartyId:   rp.Id,
+		Timeout:          rp.Timeout.Milliseconds(),
+		AllowCredentials: descriptors(allow),
+		UserVerification: "required",
+	}
+}
+
+// Parsing collected client data json.
+func ParseClientData(data []byte) (ClientData, error) {
+	var clientData ClientData
+
+	if err := json.Unmarshal(data, &clientData); err != nil {
+		return ClientData{}, ErrClientData
+	}
+
+	return clientData, nil
+}
+
+// Verifying a registration ceremony and getting a new credential.
+func (rp *RelyingParty) VerifyRegistration(clientData ClientData, attestation []byte) (Credential, error) {
+	// Verifying collected client data.
+	if err := rp.verifyClientData(clientData, TypeCreate); err != nil {
+		return Credential{}, err
+	}
+
+	// Decoding attestation object.
+	var object attestationObject
+	if err := cbor.Unmarshal(attestation, &object); err != nil {
+		return Credential{}, ErrAttestation
+	}
+
+	// Only none attestation is requested from authenticators.
+	if object.Format != "none" {
+		return Credential{}, ErrAttestation
+	}
+
+	// Parsing and verifying authenticator data.
+	authData, err := rp.parseAuthenticatorData(object.AuthData)
+	if err != nil {
+		return Credential{}, err
+	} else if authData.flags&flagAttestedCredData == 0 {
+		return Credential{}, ErrAuthenticatorData
+	}
+
+	// Check that the credential public key is supported.
+	if _, err := ParsePublicKey(authData.publicKey); err != nil {
+		return Credential{}, err
+	}
+
+	return Credential{
+		Id:        authData.credentialId,
+		PublicKey: authData.publicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// Verifying an assertion ceremony and getting the new signature counter.
+func (rp *RelyingParty) VerifyAssertion(clientData ClientData, clientDataJSON, authenticatorData, signature, publicKey []byte) (uint32, error) {
+	// Verifying collected client data.
+	if err := rp.verifyClientData(clientData, TypeGet); err != nil {
+		return 0, err
+	}
+
+	// Parsing and verifying authenticator data.
+	authData, err := rp.parseAuthenticatorData(authenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	// Verifying signature over authenticator data and client data hash.
+	hash := sha256.Sum256(clientDataJSON)
+	if err := VerifySignature(publicKey, append(append([]byte{}, authenticatorData...), hash[:]...), signature); err != nil {
+		return 0, err
+	}
+
+	return authData.signCount, nil
+}
+
+// Verifying collected client data type and origin.
+func (rp *RelyingParty) verifyClientData(clientData ClientData, typ string) error {
+	if clientData.Type != typ {
+		return ErrClientData
+	}
+
+	for _, origin := range rp.Origins {
+		if clientData.Origin == origin {
+			return nil
+		}
+	}
+
+	return ErrClientData
+}
+
+// Parsing authenticator data and verifying relying party id hash.
+func (rp *RelyingParty) parseAuthenticatorData(data []byte) (authenticatorData, error) {
+	// Authenticator data is at least rp id hash, flags and sign count.
+	if len(data) < 37 {
+		return authenticatorData{}, ErrAuthenticatorData
+	}
+
+	authData := authenticatorData{
+		rpIdHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	// Verifying relying party id hash.
+	hash := sha256.Sum256([]byte(rp.Id))
+	if subtle.ConstantTimeCompare(authData.rpIdHash, hash[:]) != 1 {
+		return authenticatorData{}, ErrAuthenticatorData
+	}
+
+	// Check that the user was present and verified, presence alone is not enough for passwordless
+	// sign in.
+	if authData.flags&flagUserPresent == 0 || authData.flags&flagUserVerified == 0 {
+		return authenticatorData{}, ErrAuthenticatorData
+	}
+
+	// Parsing attested credential data.
+	if authData.flags&flagAttestedCredData != 0 {
+		// Attested credential data is aaguid, credential id length and credential id.
+		rest := data[37:]
+		if len(rest) < 18 {
+			return authenticatorData{}, ErrAuthenticatorData
+		}
+
+		length := int(binary.BigEndian.Uint16(rest[16:18]))
+		if len(rest) < 18+length {
+			return authenticatorData{}, ErrAuthenticatorData
+		}
+
+		authData.credentialId = rest[18 : 18+length]
+
+		// Decoding the public key to find where it ends.
+		var key cbor.RawMessage
+		decoder := cbor.NewDecoder(bytes.NewReader(rest[18+length:]))
+		if err := decoder.Decode(&key); err != nil {
+			return authenticatorData{}, ErrAuthenticatorData
+		}
+
+		authData.publicKey = key
+	}
+
+	return authData, nil
+}
+
+// Getting public key credential descriptors.
+func descriptors(ids [][]byte) []CredentialDescriptor {
+	list := make([]CredentialDescriptor, len(ids))
+
+	for i, id := range ids {
+		list[i] = CredentialDescriptor{Type: "public-key", Id: id}
+	}
+
+	return list
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package webauthn_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/durudex/durudex-user-service/pkg/webauthn"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Testing relying party.
+var rp = webauthn.NewRelyingParty("durudex.com", "Durudex", []string{"https://durudex.com"}, time.Minute)
+
+// Testing authenticator structure.
+type authenticator struct {
+	id   []byte
+	key  []byte
+	sign func(data []byte) []byte
+}
+
+// Creating a new testing ES256 authenticator.
+func newES256Authenticator(t *testing.T) authenticator {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err.Error())
+	}
+
+	key, _ := cbor.Marshal(map[int]interface{}{
+		1: 2, 3: -7, -1: 1,
+		-2: priv.X.FillBytes(make([]byte, 32)),
+		-3: priv.Y.FillBytes(make([]byte, 32)),
+	})
+
+	return authenticator{id: []byte("es256-credential"), key: key, sign: func(data []byte) []byte {
+		sum := sha256.Sum256(data)
+		sig, _ := ecdsa.SignASN1(rand.Reader, priv, sum[:])
+		return sig
+	}}
+}
+
+// Creating a new testing EdDSA authenticator.
+func newEdDSAAuthenticator(t *testing.T) authenticator {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err.Error())
+	}
+
+	key, _ := cbor.Marshal(map[int]interface{}{1: 1, 3: -8, -1: 6, -2: []byte(pub)})
+
+	return authenticator{id: []byte("eddsa-credential"), key: key, sign: func(data []byte) []byte {
+		return ed25519.Sign(priv, data)
+	}}
+}
+
+// Building authenticator data.
+func (a authenticator) authData(rpId string, signCount uint32, attested, verified bool) []byte {
+	hash := sha256.Sum256([]byte(rpId))
+
+	var buf bytes.Buffer
+	buf.Write(hash[:])
+
+	flags := byte(0x01)
+	if verified {
+		flags |= 0x04
+	}
+	if attested {
+		flags |= 0x40
+	}
+	buf.WriteByte(flags)
+
+	_ = binary.Write(&buf, binary.BigEndian, signCount)
+
+	if attested {
+		buf.Write(make([]byte, 16))
+		_ = binary.Write(&buf, binary.BigEndian, uint16(len(a.id)))
+		buf.Write(a.id)
+		buf.Write(a.key)
+	}
+
+	return buf.Bytes()
+}
+
+// Building collected client data json.
+func clientDataJSON(typ, challenge, origin string) []byte {
+	data, _ := json.Marshal(webauthn.ClientData{Type: typ, Challenge: challenge, Origin: origin})
+	return data
+}
+
+// Testing verifying a registration ceremony.
+func TestRelyingParty_VerifyRegistration(t *testing.T) {
+	auth := newES256Authenticator(t)
+
+	// Testing args.
+	type args struct {
+		clientData  []byte
+		attestation map[string]interface{}
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			args: args{
+				clientData:  clientDataJSON(webauthn.TypeCreate, "challenge", "https://durudex.com"),
+				attestation: map[string]interface{}{"fmt": "none", "attStmt": map[string]interface{}{}, "authData": auth.authData("durudex.com", 0, true, true)},
+			},
+		},
+		{
+			name: "Invalid Origin",
+			args: args{
+				clientData:  clientDataJSON(webauthn.TypeCreate, "challenge", "https://example.com"),
+				attestation: map[string]interface{}{"fmt": "none", "attStmt": map[string]interface{}{}, "authData": auth.authData("durudex.com", 0, true, true)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Type",
+			args: args{
+				clientData:  clientDataJSON(webauthn.TypeGet, "challenge", "https://durudex.com"),
+				attestation: map[string]interface{}{"fmt": "none", "attStmt": map[string]interface{}{}, "authData": auth.authData("durudex.com", 0, true, true)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Relying Party",
+			args: args{
+				clientData:  clientDataJSON(webauthn.TypeCreate, "challenge", "https://durudex.com"),
+				attestation: map[string]interface{}{"fmt": "none", "attStmt": map[string]interface{}{}, "authData": auth.authData("example.com", 0, true, true)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "User Not Verified",
+			args: args{
+				clientData:  clientDataJSON(webauthn.TypeCreate, "challenge", "https://durudex.com"),
+				attestation: map[string]interface{}{"fmt": "none", "attStmt": map[string]interface{}{}, "authData": auth.authData("durudex.com", 0, true, false)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported Attestation",
+			args: args{
+				clientData:  clientDataJSON(webauthn.TypeCreate, "challenge", "https://durudex.com"),
+				attestation: map[string]interface{}{"fmt": "packed", "attStmt": map[string]interface{}{}, "authData": auth.authData("durudex.com", 0, true, true)},
+			},
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Parsing collected client data.
+			clientData, err := webauthn.ParseClientData(tt.args.clientData)
+			if err != nil {
+				t.Fatalf("error parsing client data: %s", err.Error())
+			}
+
+			attestation, _ := cbor.Marshal(tt.args.attestation)
+
+			// Verifying a registration ceremony.
+			got, err := rp.VerifyRegistration(clientData, attestation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error verifying registration: %v", err)
+			}
+
+			// Check for similarity of credential.
+			if !tt.wantErr && (!bytes.Equal(got.Id, auth.id) || !bytes.Equal(got.PublicKey, auth.key)) {
+				t.Error("error credential are not similar")
+			}
+		})
+	}
+}
+
+// Testing verifying an assertion ceremony.
+func TestRelyingParty_VerifyAssertion(t *testing.T) {
+	es256 := newES256Authenticator(t)
+	eddsa := newEdDSAAuthenticator(t)
+	other := newEdDSAAuthenticator(t)
+
+	// Testing args.
+	type args struct {
+		auth       authenticator
+		signer     authenticator
+		typ        string
+		signCount  uint32
+		unverified bool
+	}
+
+	// Tests structures.
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "ES256",
+			args: args{auth: es256, signer: es256, typ: webauthn.TypeGet, signCount: 1},
+		},
+		{
+			name: "EdDSA",
+			args: args{auth: eddsa, signer: eddsa, typ: webauthn.TypeGet, signCount: 7},
+		},
+		{
+			name:    "Invalid Signature",
+			args:    args{auth: eddsa, signer: other, typ: webauthn.TypeGet},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Type",
+			args:    args{auth: eddsa, signer: eddsa, typ: webauthn.TypeCreate},
+			wantErr: true,
+		},
+		{
+			name:    "User Not Verified",
+			args:    args{auth: eddsa, signer: eddsa, typ: webauthn.TypeGet, unverified: true},
+			wantErr: true,
+		},
+	}
+
+	// Conducting tests in various structures.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := clientDataJSON(tt.args.typ, "challenge", "https://durudex.com")
+			authData := tt.args.auth.authData("durudex.com", tt.args.signCount, false, !tt.args.unverified)
+
+			// Signing authenticator data and client data hash.
+			hash := sha256.Sum256(data)
+			signature := tt.args.signer.sign(append(append([]byte{}, authData...), hash[:]...))
+
+			// Parsing collected client data.
+			clientData, err := webauthn.ParseClientData(data)
+			if err != nil {
+				t.Fatalf("error parsing client data: %s", err.Error())
+			}
+
+			// Verifying an assertion ceremony.
+			got, err := rp.VerifyAssertion(clientData, data, authData, signature, tt.args.auth.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error verifying assertion: %v", err)
+			}
+
+			// Check for similarity of sign count.
+			if !tt.wantErr && got != tt.args.signCount {
+				t.Errorf("error sign count are not similar: %d", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+ALTER TABLE "user_session" ADD COLUMN IF NOT EXISTS "family_id" CHAR(27);
+ALTER TABLE "user_session" ADD COLUMN IF NOT EXISTS "rotated_at" TIMESTAMP;
+
+UPDATE "user_session" SET "family_id"="id" WHERE "family_id" IS NULL;
+
+ALTER TABLE "user_session" ALTER COLUMN "family_id" SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS "user_session_family_id_idx" ON "user_session" ("family_id");
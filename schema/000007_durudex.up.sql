@@ -0,0 +1,27 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+CREATE TABLE IF NOT EXISTS "user_credential" (
+  "id"           BYTEA     NOT NULL PRIMARY KEY,
+  "user_id"      CHAR(27)  NOT NULL REFERENCES "user" ("id") ON DELETE CASCADE,
+  "public_key"   BYTEA     NOT NULL,
+  "sign_count"   BIGINT    NOT NULL DEFAULT 0,
+  "created_at"   TIMESTAMP NOT NULL DEFAULT now(),
+  "last_used_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "user_credential_user_id_idx" ON "user_credential" ("user_id");
@@ -0,0 +1,26 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+CREATE TABLE IF NOT EXISTS "user_profile" (
+  "user_id"      CHAR(27)       NOT NULL PRIMARY KEY REFERENCES "user" ("id") ON DELETE CASCADE,
+  "display_name" VARCHAR(64)    NOT NULL DEFAULT '',
+  "bio"          VARCHAR(500)   NOT NULL DEFAULT '',
+  "locale"       VARCHAR(35)    NOT NULL DEFAULT '',
+  "timezone"     VARCHAR(64)    NOT NULL DEFAULT '',
+  "links"        VARCHAR(255)[] NOT NULL DEFAULT '{}',
+  "updated_at"   TIMESTAMP      NOT NULL DEFAULT now()
+);
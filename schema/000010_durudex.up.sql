@@ -0,0 +1,22 @@
+/*
+ * Copyright © 2022 Durudex
+ *
+ * This file is part of Durudex: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Durudex is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Durudex. If not, see <https://www.gnu.org/licenses/>.
+ */
+
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+CREATE INDEX IF NOT EXISTS "user_username_prefix_idx" ON "user" (lower("username") text_pattern_ops);
+CREATE INDEX IF NOT EXISTS "user_username_trgm_idx" ON "user" USING gin (lower("username") gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS "user_id_cursor_idx" ON "user" ("id" COLLATE "C");